@@ -0,0 +1,56 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+)
+
+// stripANSI removes ANSI escape sequences from the string. The
+// function removes CSI sequences, such as the SGR color codes
+// "\x1b[31m", and OSC sequences, such as hyperlinks, which are
+// terminated by BEL or ST.
+func stripANSI(s string) string {
+	if strings.IndexByte(s, '\x1b') < 0 {
+		return s
+	}
+	var sb strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] != '\x1b' || i+1 >= len(s) {
+			sb.WriteByte(s[i])
+			continue
+		}
+		switch s[i+1] {
+		case '[':
+			// CSI: parameter and intermediate bytes followed by
+			// a final byte in range 0x40-0x7e.
+			i += 2
+			for i < len(s) && (s[i] < 0x40 || s[i] > 0x7e) {
+				i++
+			}
+
+		case ']':
+			// OSC: terminated by BEL or ST (ESC \).
+			i += 2
+			for i < len(s) {
+				if s[i] == '\x07' {
+					break
+				}
+				if s[i] == '\x1b' && i+1 < len(s) && s[i+1] == '\\' {
+					i++
+					break
+				}
+				i++
+			}
+
+		default:
+			// Two-byte escape sequence.
+			i++
+		}
+	}
+	return sb.String()
+}
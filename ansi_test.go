@@ -0,0 +1,71 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestStripANSI(t *testing.T) {
+	tests := []struct {
+		input    string
+		expected string
+	}{
+		{"plain", "plain"},
+		{"\x1b[31mred\x1b[m", "red"},
+		{"\x1b[1;32mbold green\x1b[0m!", "bold green!"},
+		{"\x1b]8;;http://example.com\x07link\x1b]8;;\x07", "link"},
+		{"\x1b]8;;http://example.com\x1b\\link\x1b]8;;\x1b\\", "link"},
+		{"trailing\x1b", "trailing\x1b"},
+	}
+	for _, test := range tests {
+		result := stripANSI(test.input)
+		if result != test.expected {
+			t.Errorf("stripANSI(%q) = %q, expected %q",
+				test.input, result, test.expected)
+		}
+	}
+}
+
+func TestANSIWidth(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Status")
+	tab.Row().Column("\x1b[32mok\x1b[m")
+	tab.Row().Column("failed")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +--------+
+        | Status |
+        +--------+
+        | ` + "\x1b[32mok\x1b[m" + `     |
+        | failed |
+        +--------+
+`
+	match(t, sb.String(), expected, "TestANSIWidth")
+
+	tab = New(CSV)
+	tab.Row().Column("\x1b[32mok\x1b[m")
+	sb.Reset()
+	tab.Print(&sb)
+	match(t, sb.String(), "ok", "TestANSIWidth CSV")
+
+	tab = New(JSON)
+	row := tab.Row()
+	row.Column("\x1b[1mkey\x1b[m")
+	row.Column("\x1b[32mok\x1b[m")
+	data, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	if string(data) != `{"key":"ok"}` {
+		t.Errorf("TestANSIWidth JSON: got %s", data)
+	}
+}
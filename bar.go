@@ -0,0 +1,70 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+)
+
+// Bar chart elements.
+const (
+	barFull  = "▇"
+	barEmpty = "▁"
+)
+
+// BarChart appends a bar chart column to the table. The bars show the
+// numeric values of the table column col, scaled so that the column
+// maximum value fills the width characters. The cells which are not
+// positive numbers get empty bars and the non-numeric cells are left
+// empty. If the table has headers, the bar column gets the argument
+// header label. The function returns the bar column header or nil if
+// the table does not have headers.
+func (t *Tabulate) BarChart(col int, label string, width int) *Column {
+	idx := t.numColumns()
+	if width < 0 {
+		width = 0
+	}
+
+	var max float64
+	for _, row := range t.Rows {
+		if c := row.cell(col); c != nil && !row.group {
+			if v, ok := parseNumber(c.Content(0)); ok && v > max {
+				max = v
+			}
+		}
+	}
+	for _, row := range t.Rows {
+		if row.group {
+			continue
+		}
+		c := row.cell(col)
+		if c == nil {
+			continue
+		}
+		v, ok := parseNumber(c.Content(0))
+		if !ok {
+			continue
+		}
+		var n int
+		if max > 0 && v > 0 {
+			n = int(v/max*float64(width) + 0.5)
+		}
+		if n > width {
+			n = width
+		}
+		row.Set(idx, NewText(strings.Repeat(barFull, n)+
+			strings.Repeat(barEmpty, width-n)))
+	}
+
+	if len(t.Headers) == 0 {
+		return nil
+	}
+	for len(t.Headers) < idx {
+		t.Header("")
+	}
+	return t.Header(label)
+}
@@ -0,0 +1,74 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestBarChart(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Name")
+	tab.Header("Count")
+	tab.AddRow("a", 40)
+	tab.AddRow("b", "1,000")
+	tab.AddRow("c", -5)
+	tab.AddRow("d", "n/a")
+	tab.AddRow("e", "Inf")
+	tab.BarChart(1, "Chart", 5)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+         Name  Count  Chart
+         a     40     ▁▁▁▁▁
+         b     1,000  ▇▇▇▇▇
+         c     -5     ▁▁▁▁▁
+         d     n/a
+         e     Inf
+`, "TestBarChart")
+}
+
+func TestBarChartWidth(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Count")
+	tab.AddRow(1)
+	tab.AddRow(2)
+	tab.BarChart(0, "Chart", 0)
+	tab.BarChart(0, "Neg", -3)
+
+	match(t, tab.String(), `
+         Count  Chart  Neg
+         1
+         2
+`, "TestBarChartWidth")
+}
+
+func TestParseNumber(t *testing.T) {
+	tests := []struct {
+		val string
+		ok  bool
+		v   float64
+	}{
+		{"42", true, 42},
+		{" -1.5 ", true, -1.5},
+		{"1,234,567.5", true, 1234567.5},
+		{"1,00", false, 0},
+		{"12,34,567", false, 0},
+		{"Inf", false, 0},
+		{"NaN", false, 0},
+		{"1e999", false, 0},
+	}
+	for _, test := range tests {
+		v, ok := parseNumber(test.val)
+		if ok != test.ok || v != test.v {
+			t.Errorf("parseNumber(%q) = %v, %v, expected %v, %v",
+				test.val, v, ok, test.v, test.ok)
+		}
+	}
+}
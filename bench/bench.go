@@ -0,0 +1,98 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+// Package bench implements synthetic table generators for
+// benchmarking the tabulate package.
+package bench
+
+import (
+	"math/rand"
+	"strings"
+
+	"github.com/markkurossi/tabulate"
+)
+
+// Params define the synthetic table parameters.
+type Params struct {
+	Rows      int
+	Cols      int
+	CellSize  int
+	WideRatio float64
+	Seed      int64
+}
+
+var (
+	narrow = []rune("abcdefghijklmnopqrstuvwxyz0123456789")
+	wide   = []rune("我是测试表格数据中文字符")
+)
+
+// Generator creates synthetic table data.
+type Generator struct {
+	params Params
+	rand   *rand.Rand
+}
+
+// NewGenerator creates a new generator for the parameters.
+func NewGenerator(params Params) *Generator {
+	return &Generator{
+		params: params,
+		rand:   rand.New(rand.NewSource(params.Seed)),
+	}
+}
+
+// Cell creates a random cell value. The value has CellSize runes and
+// WideRatio of the runes are East Asian Wide characters.
+func (g *Generator) Cell() string {
+	var sb strings.Builder
+	for i := 0; i < g.params.CellSize; i++ {
+		if g.rand.Float64() < g.params.WideRatio {
+			sb.WriteRune(wide[g.rand.Intn(len(wide))])
+		} else {
+			sb.WriteRune(narrow[g.rand.Intn(len(narrow))])
+		}
+	}
+	return sb.String()
+}
+
+// Strings creates a random string matrix with Rows rows and Cols
+// columns.
+func (g *Generator) Strings() [][]string {
+	result := make([][]string, g.params.Rows)
+	for r := range result {
+		row := make([]string, g.params.Cols)
+		for c := range row {
+			row[c] = g.Cell()
+		}
+		result[r] = row
+	}
+	return result
+}
+
+// Table creates a random table with the style. The table has Cols
+// header columns and Rows data rows.
+func (g *Generator) Table(style tabulate.Style) *tabulate.Tabulate {
+	tab := tabulate.New(style)
+	for c := 0; c < g.params.Cols; c++ {
+		tab.Header(g.Cell())
+	}
+	for _, r := range g.Strings() {
+		row := tab.Row()
+		for _, c := range r {
+			row.Column(c)
+		}
+	}
+	return tab
+}
+
+// Map creates a random map with Rows entries. Each entry maps a
+// random key to a slice of Cols random values.
+func (g *Generator) Map() map[string][]string {
+	result := make(map[string][]string)
+	for _, r := range g.Strings() {
+		result[g.Cell()] = r
+	}
+	return result
+}
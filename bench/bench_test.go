@@ -0,0 +1,163 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package bench
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/markkurossi/tabulate"
+)
+
+var sizes = []Params{
+	{Rows: 10, Cols: 4, CellSize: 8},
+	{Rows: 1000, Cols: 8, CellSize: 16},
+	{Rows: 1000, Cols: 8, CellSize: 16, WideRatio: 0.5},
+}
+
+func name(p Params) string {
+	return fmt.Sprintf("%dx%dx%d/wide=%.1f", p.Rows, p.Cols, p.CellSize,
+		p.WideRatio)
+}
+
+func TestGenerator(t *testing.T) {
+	p := Params{Rows: 3, Cols: 2, CellSize: 5, WideRatio: 1}
+	tab := NewGenerator(p).Table(tabulate.Plain)
+	if len(tab.Headers) != p.Cols {
+		t.Errorf("headers: got %d, expected %d", len(tab.Headers), p.Cols)
+	}
+	if len(tab.Rows) != p.Rows {
+		t.Errorf("rows: got %d, expected %d", len(tab.Rows), p.Rows)
+	}
+	w := tab.Rows[0].Columns[0].Width(tabulate.MeasureUnicode)
+	if w != p.CellSize*2 {
+		t.Errorf("cell width: got %d, expected %d", w, p.CellSize*2)
+	}
+}
+
+func BenchmarkPrint(b *testing.B) {
+	for _, p := range sizes {
+		tab := NewGenerator(p).Table(tabulate.Unicode)
+		b.Run(name(p), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				tab.Print(io.Discard)
+			}
+		})
+	}
+}
+
+func BenchmarkPrintParallel(b *testing.B) {
+	for _, p := range sizes {
+		tab := NewGenerator(p).Table(tabulate.Unicode)
+		tab.SetParallel(4)
+		b.Run(name(p), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				tab.Print(io.Discard)
+			}
+		})
+	}
+}
+
+// countingWriter counts the Write calls, modeling the system calls
+// of an unbuffered writer.
+type countingWriter struct {
+	writes int
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.writes++
+	return len(p), nil
+}
+
+func BenchmarkPrintWrites(b *testing.B) {
+	for _, p := range sizes {
+		tab := NewGenerator(p).Table(tabulate.Unicode)
+		b.Run(name(p), func(b *testing.B) {
+			b.ReportAllocs()
+			w := new(countingWriter)
+			for i := 0; i < b.N; i++ {
+				tab.Print(w)
+			}
+			b.ReportMetric(float64(w.writes)/float64(b.N), "writes/op")
+		})
+	}
+}
+
+func BenchmarkNewValue(b *testing.B) {
+	values := []interface{}{42, int64(-7), uint(3), true, 3.14, "text"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		for _, v := range values {
+			tabulate.NewValue(v)
+		}
+	}
+}
+
+func BenchmarkMeasure(b *testing.B) {
+	g := NewGenerator(Params{CellSize: 32, WideRatio: 0.5})
+	cell := g.Cell()
+	for _, m := range []struct {
+		name    string
+		measure tabulate.Measure
+	}{
+		{"runes", tabulate.MeasureRunes},
+		{"unicode", tabulate.MeasureUnicode},
+	} {
+		b.Run(m.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				m.measure(cell)
+			}
+		})
+	}
+}
+
+func BenchmarkSliceString(b *testing.B) {
+	arr := tabulate.NewSlice(80)
+	for i := 0; i < 100; i++ {
+		arr.Append(tabulate.NewValue(i))
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_ = arr.String()
+	}
+}
+
+func BenchmarkReflect(b *testing.B) {
+	for _, p := range sizes {
+		m := NewGenerator(p).Map()
+		b.Run(name(p), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				tab := tabulate.New(tabulate.Unicode)
+				err := tabulate.Reflect(tab, 0, nil, m)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkJSON(b *testing.B) {
+	for _, p := range sizes {
+		tab := NewGenerator(p).Table(tabulate.JSON)
+		b.Run(name(p), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_, err := json.Marshal(tab)
+				if err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
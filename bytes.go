@@ -0,0 +1,72 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+	"math"
+)
+
+var (
+	binaryUnits  = []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB", "EiB"}
+	decimalUnits = []string{"B", "kB", "MB", "GB", "TB", "PB", "EB"}
+)
+
+// NewBytes creates a new Value for the byte size n. The size is
+// rendered in binary units, for example "1.2 GiB". The JSON
+// marshaling uses the exact byte count.
+func NewBytes(n int64) *Value {
+	return &Value{
+		string: humanizeBytes(n, 1024, binaryUnits),
+		value:  n,
+	}
+}
+
+// NewBytesDecimal creates a new Value for the byte size n. The size
+// is rendered in decimal units, for example "1.3 GB". The JSON
+// marshaling uses the exact byte count.
+func NewBytesDecimal(n int64) *Value {
+	return &Value{
+		string: humanizeBytes(n, 1000, decimalUnits),
+		value:  n,
+	}
+}
+
+// humanizeBytes formats the byte size n with the units of the base.
+// Sizes below 10 units are formatted with one decimal and larger
+// sizes are rounded to integers. The unit is chosen after rounding so
+// that the sizes are never shown as base units of the smaller unit,
+// and the zero decimals are omitted.
+func humanizeBytes(n, base int64, units []string) string {
+	sign := ""
+	v := float64(n)
+	if n < 0 {
+		sign = "-"
+		v = -v
+	}
+	if v < float64(base) {
+		return fmt.Sprintf("%s%d %s", sign, int64(v), units[0])
+	}
+	var unit int
+	for roundSize(v) >= float64(base) && unit+1 < len(units) {
+		v /= float64(base)
+		unit++
+	}
+	v = roundSize(v)
+	if v < 10 && v != math.Trunc(v) {
+		return fmt.Sprintf("%s%.1f %s", sign, v, units[unit])
+	}
+	return fmt.Sprintf("%s%.0f %s", sign, v, units[unit])
+}
+
+// roundSize rounds the size to its displayed precision.
+func roundSize(v float64) float64 {
+	if v < 10 {
+		return math.Round(v*10) / 10
+	}
+	return math.Round(v)
+}
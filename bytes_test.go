@@ -0,0 +1,37 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"testing"
+)
+
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		n       int64
+		binary  string
+		decimal string
+	}{
+		{0, "0 B", "0 B"},
+		{512, "512 B", "512 B"},
+		{1536, "1.5 KiB", "1.5 kB"},
+		{1288490189, "1.2 GiB", "1.3 GB"},
+		{50 * 1024 * 1024, "50 MiB", "52 MB"},
+		{-2048, "-2 KiB", "-2 kB"},
+		{1048575, "1 MiB", "1 MB"},
+		{10239, "10 KiB", "10 kB"},
+	}
+	for _, test := range tests {
+		if s := NewBytes(test.n).String(); s != test.binary {
+			t.Errorf("NewBytes(%d) = %q, expected %q", test.n, s, test.binary)
+		}
+		if s := NewBytesDecimal(test.n).String(); s != test.decimal {
+			t.Errorf("NewBytesDecimal(%d) = %q, expected %q",
+				test.n, s, test.decimal)
+		}
+	}
+}
@@ -0,0 +1,140 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+)
+
+// ColorMode specifies when the VT100 colors and text formatting are
+// printed.
+type ColorMode int
+
+// Color modes. The ColorAlways mode prints the formatting unless the
+// NoColors is set. The ColorAuto mode removes all ANSI escape
+// sequences from the output if the NO_COLOR environment variable is
+// set, if the output writer is not a terminal, or if the terminal does
+// not support the escape sequences. The detection is not done in the
+// deterministic mode. The ColorNever mode always removes all ANSI
+// escape sequences from the output. When the escape sequences are
+// printed to a Windows console, their processing is enabled
+// automatically.
+const (
+	ColorAlways ColorMode = iota
+	ColorAuto
+	ColorNever
+)
+
+var colorModes = map[ColorMode]string{
+	ColorAlways: "always",
+	ColorAuto:   "auto",
+	ColorNever:  "never",
+}
+
+func (m ColorMode) String() string {
+	name, ok := colorModes[m]
+	if ok {
+		return name
+	}
+	return fmt.Sprintf("{ColorMode %d}", m)
+}
+
+// ParseColorMode parses the color mode name. The names are "always",
+// "auto", and "never", and they are matched case insensitively.
+func ParseColorMode(name string) (ColorMode, error) {
+	for mode, n := range colorModes {
+		if strings.EqualFold(n, name) {
+			return mode, nil
+		}
+	}
+	return ColorAlways, fmt.Errorf("unknown color mode: %s", name)
+}
+
+// Set implements the flag.Value.Set() for color mode command line
+// flags.
+func (m *ColorMode) Set(value string) error {
+	mode, err := ParseColorMode(value)
+	if err != nil {
+		return err
+	}
+	*m = mode
+	return nil
+}
+
+// SetColor sets the color mode. The ColorAlways and ColorNever modes
+// also clear and set the NoColors field.
+func (t *Tabulate) SetColor(mode ColorMode) {
+	t.colorMode = mode
+	switch mode {
+	case ColorAlways:
+		t.NoColors = false
+	case ColorNever:
+		t.NoColors = true
+	}
+	t.asData = nil
+}
+
+// stripColors tests if the ANSI escape sequences are removed from the
+// output written to the writer. If the escape sequences are printed,
+// the function enables their processing in Windows consoles.
+func (t *Tabulate) stripColors(o io.Writer) bool {
+	switch t.colorMode {
+	case ColorNever:
+		return true
+	case ColorAuto:
+		if t.Deterministic {
+			return false
+		}
+		if len(os.Getenv("NO_COLOR")) > 0 || !isTerminal(o) {
+			return true
+		}
+		// Windows consoles need the VT100 processing to be enabled.
+		return EnableVirtualTerminal(o) != nil
+	default:
+		// The escape sequences are printed even if the console
+		// does not support them.
+		EnableVirtualTerminal(o)
+		return false
+	}
+}
+
+// isTerminal tests if the writer, or the writer it wraps, is a
+// terminal.
+func isTerminal(o io.Writer) bool {
+	f := underlyingFile(o)
+	if f == nil {
+		return false
+	}
+	fi, err := f.Stat()
+	if err != nil {
+		return false
+	}
+	return fi.Mode()&os.ModeCharDevice != 0
+}
+
+// underlyingFile returns the file the writer, or the writer it wraps,
+// writes to. The function returns nil if the writer does not write to
+// a file.
+func underlyingFile(o io.Writer) *os.File {
+	for {
+		switch w := o.(type) {
+		case *countWriter:
+			o = w.w
+		case *lineWriter:
+			o = w.w
+		case *bufferedWriter:
+			o = w.w
+		case *os.File:
+			return w
+		default:
+			return nil
+		}
+	}
+}
@@ -0,0 +1,60 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func colorTable(mode ColorMode) *Tabulate {
+	tab := New(Plain)
+	tab.Header("Name").SetFormat(FmtBold)
+	tab.AddRow(NewText("\x1b[31malpha\x1b[0m"))
+	tab.SetColor(mode)
+	return tab
+}
+
+func TestColorMode(t *testing.T) {
+	t.Setenv("NO_COLOR", "")
+
+	var sb strings.Builder
+	colorTable(ColorAlways).Print(&sb)
+	if !strings.Contains(sb.String(), "\x1b[") {
+		t.Errorf("ColorAlways: no escape sequences: %q", sb.String())
+	}
+
+	for _, mode := range []ColorMode{ColorAuto, ColorNever} {
+		sb.Reset()
+		colorTable(mode).Print(&sb)
+		if sb.String() != " Name  \n alpha \n" {
+			t.Errorf("%s: got %q", mode, sb.String())
+		}
+	}
+
+	sb.Reset()
+	tab := colorTable(ColorAuto)
+	tab.SetDeterministic(true)
+	tab.Print(&sb)
+	if !strings.Contains(sb.String(), "\x1b[") {
+		t.Errorf("ColorAuto: deterministic output stripped: %q", sb.String())
+	}
+}
+
+func TestParseColorMode(t *testing.T) {
+	for mode, name := range colorModes {
+		parsed, err := ParseColorMode(strings.ToUpper(name))
+		if err != nil {
+			t.Errorf("ParseColorMode(%s) failed: %v", name, err)
+		} else if parsed != mode {
+			t.Errorf("ParseColorMode(%s) = %s", name, parsed)
+		}
+	}
+	if _, err := ParseColorMode("sometimes"); err == nil {
+		t.Errorf("ParseColorMode accepted an unknown mode")
+	}
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+// SetCompact sets the compact row storage mode. In the compact mode,
+// the rows added with AddStrings are stored as string slices instead
+// of rows of columns. The compact rows are converted to transient
+// rows when the table is rendered so they use a fraction of the
+// memory of the normal rows. The compact rows are not included in the
+// Rows field and they are rendered after the normal rows. The column
+// views, splitting, vertical mode, and custom outputs expand all
+// compact rows for the duration of the rendering.
+func (t *Tabulate) SetCompact(compact bool) {
+	t.compact = compact
+}
+
+// AddStrings adds a new data row with the argument string values. In
+// the compact mode, the row is stored as a string slice. Otherwise
+// the values are added as text columns to a new data row.
+func (t *Tabulate) AddStrings(values ...string) {
+	if !t.compact {
+		row := &Row{
+			Tab: t,
+		}
+		for _, v := range values {
+			row.Column(v)
+		}
+		t.appendRow(row)
+		return
+	}
+	t.mu.Lock()
+	t.compactRows = append(t.compactRows, values)
+	t.modified()
+	t.mu.Unlock()
+}
+
+// NumRows returns the number of data rows in the table, including the
+// compact rows.
+func (t *Tabulate) NumRows() int {
+	return len(t.Rows) + len(t.compactRows)
+}
+
+// eachRow calls the argument function for each data row of the table.
+// The compact rows are converted to transient rows.
+func (t *Tabulate) eachRow(fn func(row *Row)) {
+	for _, row := range t.Rows {
+		fn(row)
+	}
+	for _, values := range t.compactRows {
+		fn(t.compactRow(values))
+	}
+}
+
+// compactRow converts the compact row values to a row.
+func (t *Tabulate) compactRow(values []string) *Row {
+	row := &Row{
+		Tab:     t,
+		Columns: make([]*Column, 0, len(values)),
+	}
+	for _, v := range values {
+		var hdr *Column
+		idx := len(row.Columns)
+		if idx < len(t.Headers) {
+			hdr = t.Headers[idx]
+		} else {
+			hdr = emptyColumn
+			if idx < len(t.Defaults) {
+				hdr = &Column{
+					Align: t.Defaults[idx],
+				}
+			}
+		}
+		row.Columns = append(row.Columns, &Column{
+			Align:    hdr.Align,
+			Data:     NewLines(v),
+			Format:   hdr.Format,
+			alignSet: hdr.alignSet,
+		})
+	}
+	return row
+}
+
+// compactLayout tests if the table can be rendered without expanding
+// its compact rows.
+func (t *Tabulate) compactLayout() bool {
+	return t.visibleColumns() == nil && t.renderer == nil &&
+		t.Output == nil && !t.split && !t.Vertical
+}
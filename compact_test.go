@@ -0,0 +1,98 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func compactTables(style Style) (*Tabulate, *Tabulate) {
+	rows := [][]string{
+		{"alpha", "1"},
+		{"beta", "22"},
+		{"gamma\ndelta", "333"},
+	}
+	normal := New(style)
+	compact := New(style)
+	compact.SetCompact(true)
+	for _, tab := range []*Tabulate{normal, compact} {
+		tab.Header("Name")
+		tab.Header("Count").SetAlign(MR)
+		for _, row := range rows {
+			tab.AddStrings(row...)
+		}
+	}
+	return normal, compact
+}
+
+func TestCompact(t *testing.T) {
+	for _, style := range []Style{Unicode, CSV, JSON} {
+		normal, compact := compactTables(style)
+		if len(compact.Rows) != 0 || compact.NumRows() != 3 {
+			t.Errorf("TestCompact: Rows=%d, NumRows=%d",
+				len(compact.Rows), compact.NumRows())
+		}
+		compact.SetSummary(SummaryRows)
+		normal.SetSummary(SummaryRows)
+
+		var expected, result strings.Builder
+		normal.Print(&expected)
+		compact.Print(&result)
+		if result.String() != expected.String() {
+			t.Errorf("TestCompact %s: got\n%s\nexpected\n%s",
+				style, result.String(), expected.String())
+		}
+	}
+}
+
+func TestCompactMaxRows(t *testing.T) {
+	_, compact := compactTables(ASCII)
+	compact.AddRow("first", 0)
+
+	// The normal rows are rendered before the compact rows.
+	normal := New(ASCII)
+	normal.Header("Name")
+	normal.Header("Count").SetAlign(MR)
+	normal.AddStrings("first", "0")
+	normal.AddStrings("alpha", "1")
+	normal.AddStrings("beta", "22")
+	normal.AddStrings("gamma\ndelta", "333")
+
+	for _, max := range []int{1, 2} {
+		normal.SetMaxRows(max)
+		compact.SetMaxRows(max)
+
+		var expected, result strings.Builder
+		normal.Print(&expected)
+		compact.Print(&result)
+		if result.String() != expected.String() {
+			t.Errorf("TestCompactMaxRows %d: got\n%s\nexpected\n%s",
+				max, result.String(), expected.String())
+		}
+	}
+	if compact.NumRows() != 4 {
+		t.Errorf("TestCompactMaxRows: NumRows=%d", compact.NumRows())
+	}
+}
+
+func TestCompactMarshalJSON(t *testing.T) {
+	normal, compact := compactTables(JSON)
+	expected, err := json.Marshal(normal)
+	if err != nil {
+		t.Fatalf("json.Marshal failed: %v", err)
+	}
+	result, err := json.Marshal(compact)
+	if err != nil {
+		t.Fatalf("json.Marshal failed: %v", err)
+	}
+	if string(result) != string(expected) {
+		t.Errorf("TestCompactMarshalJSON: got %s, expected %s",
+			result, expected)
+	}
+}
@@ -0,0 +1,27 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+//go:build !windows
+
+package tabulate
+
+import (
+	"io"
+)
+
+// EnableVirtualTerminal enables the VT100 escape sequence processing
+// of the Windows console. On other platforms the terminals process
+// the escape sequences natively and the function does nothing.
+func EnableVirtualTerminal(w io.Writer) error {
+	return nil
+}
+
+// consoleUTF8 tests if the writer is a Windows console with the UTF-8
+// output code page. On other platforms the writers are never consoles
+// and the ok result is false.
+func consoleUTF8(w io.Writer) (utf8, ok bool) {
+	return false, false
+}
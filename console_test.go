@@ -0,0 +1,19 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEnableVirtualTerminal(t *testing.T) {
+	var sb strings.Builder
+	if err := EnableVirtualTerminal(&sb); err != nil {
+		t.Errorf("EnableVirtualTerminal failed for non-console: %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+//go:build windows
+
+package tabulate
+
+import (
+	"io"
+	"syscall"
+)
+
+const enableVirtualTerminalProcessing = 0x0004
+
+var (
+	kernel32               = syscall.NewLazyDLL("kernel32.dll")
+	procSetConsoleMode     = kernel32.NewProc("SetConsoleMode")
+	procGetConsoleOutputCP = kernel32.NewProc("GetConsoleOutputCP")
+)
+
+// codePageUTF8 is the Windows code page identifier of UTF-8.
+const codePageUTF8 = 65001
+
+// EnableVirtualTerminal enables the VT100 escape sequence processing
+// of the Windows console. The function does nothing if the writer, or
+// the writer it wraps, is not a console. The function returns an
+// error if the console does not support the virtual terminal
+// processing.
+func EnableVirtualTerminal(w io.Writer) error {
+	f := underlyingFile(w)
+	if f == nil {
+		return nil
+	}
+	handle := syscall.Handle(f.Fd())
+
+	var mode uint32
+	if err := syscall.GetConsoleMode(handle, &mode); err != nil {
+		// Not a console.
+		return nil
+	}
+	if mode&enableVirtualTerminalProcessing != 0 {
+		return nil
+	}
+	r, _, err := procSetConsoleMode.Call(uintptr(handle),
+		uintptr(mode|enableVirtualTerminalProcessing))
+	if r == 0 {
+		return err
+	}
+	return nil
+}
+
+// consoleUTF8 tests if the writer is a console with the UTF-8 output
+// code page. The ok result is false if the writer is not a console.
+func consoleUTF8(w io.Writer) (utf8, ok bool) {
+	f := underlyingFile(w)
+	if f == nil {
+		return false, false
+	}
+	var mode uint32
+	if err := syscall.GetConsoleMode(syscall.Handle(f.Fd()), &mode); err != nil {
+		return false, false
+	}
+	cp, _, _ := procGetConsoleOutputCP.Call()
+	return cp == codePageUTF8, true
+}
@@ -0,0 +1,68 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"encoding/csv"
+	"io"
+)
+
+// outputCSV is the Output function of the CSV style. The header and
+// data rows are written with encoding/csv so each row is one CRLF
+// terminated record and the multi-line cells are kept in one quoted
+// field. The spanning cells are followed by empty fields for the
+// spanned columns and the missing cells are filled with the
+// placeholder. The group header rows are skipped.
+func outputCSV(t *Tabulate, o io.Writer) {
+	w := csv.NewWriter(o)
+	w.UseCRLF = true
+	count := t.numColumns()
+
+	if len(t.Headers) > 0 {
+		record := make([]string, 0, count)
+		for _, hdr := range t.Headers {
+			record = append(record, t.csvText(hdr))
+		}
+		for len(record) < count {
+			record = append(record, "")
+		}
+		if w.Write(record) != nil {
+			return
+		}
+	}
+	var err error
+	t.eachRow(func(row *Row) {
+		if err != nil || row.group {
+			return
+		}
+		record := make([]string, 0, count)
+		for _, col := range row.Columns {
+			record = append(record, t.csvText(t.fill(col)))
+			for i := 1; i < col.span(); i++ {
+				record = append(record, "")
+			}
+		}
+		for len(record) < count {
+			record = append(record, t.csvText(t.fill(emptyColumn)))
+		}
+		err = w.Write(record)
+	})
+	w.Flush()
+}
+
+// csvText returns the CSV field value of the column. The ANSI escape
+// sequences are removed unless the column is verbatim.
+func (t *Tabulate) csvText(col *Column) string {
+	col = t.widen(col)
+	if col.Data == nil {
+		return ""
+	}
+	if col.Verbatim {
+		return col.Data.String()
+	}
+	return stripANSI(col.Data.String())
+}
@@ -8,15 +8,29 @@ package tabulate
 
 import (
 	"fmt"
+	"reflect"
+	"strconv"
 	"strings"
+	"sync"
 )
 
 var (
 	_ = Data((&Value{}))
 	_ = Data((&Lines{}))
 	_ = Data((&Slice{}))
+	_ = Data((&Error{}))
+	_ = Data((&ID{}))
+	_ = Data((&Lazy{}))
+	_ = Data((&Null{}))
 )
 
+// wider is implemented by Data types which render a shortened
+// representation of their value. The Wide function returns the data
+// with the full value.
+type wider interface {
+	Wide() Data
+}
+
 // Data contains table cell data.
 type Data interface {
 	Width(m Measure) int
@@ -35,7 +49,44 @@ type Value struct {
 // NewValue creates a new Value for the argument value element.
 func NewValue(v interface{}) *Value {
 	return &Value{
-		string: fmt.Sprintf("%v", v),
+		string: formatValue(v),
+		value:  v,
+	}
+}
+
+// formatValue formats the value like the %v verb. The common types
+// are formatted without the fmt package overhead.
+func formatValue(v interface{}) string {
+	switch val := v.(type) {
+	case string:
+		return val
+	case int:
+		return strconv.Itoa(val)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case int32:
+		return strconv.FormatInt(int64(val), 10)
+	case uint:
+		return strconv.FormatUint(uint64(val), 10)
+	case uint64:
+		return strconv.FormatUint(val, 10)
+	case uint32:
+		return strconv.FormatUint(uint64(val), 10)
+	case bool:
+		return strconv.FormatBool(val)
+	case float64:
+		return strconv.FormatFloat(val, 'g', -1, 64)
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+// NewFloat creates a new Value for the floating point number. The
+// number is rendered with prec digits after the decimal point but the
+// JSON marshaling uses the exact value.
+func NewFloat(v float64, prec int) *Value {
+	return &Value{
+		string: strconv.FormatFloat(v, 'f', prec, 64),
 		value:  v,
 	}
 }
@@ -62,9 +113,88 @@ func (v *Value) String() string {
 	return v.string
 }
 
-// Lines implements the Data interface over an array of lines.
+// Lines implements the Data interface over an array of lines. The
+// widths are cached for the built-in measure functions.
 type Lines struct {
 	Lines []string
+	cache widthCache
+}
+
+// widthCache caches the data widths for the built-in measure
+// functions. The cache remembers the lines it was computed for so the
+// lines can be replaced or modified in place. The cache is guarded
+// with a mutex so that the same data can be measured concurrently.
+type widthCache struct {
+	mu     sync.Mutex
+	lines  []string
+	widths [numMeasures]int
+	valid  [numMeasures]bool
+}
+
+// Built-in measure functions which can be cached.
+const (
+	measureRunes = iota
+	measureUnicode
+	numMeasures
+)
+
+// measureID returns the cache index of the measure function or -1 if
+// the measure can't be cached. The closures can't be cached since all
+// instances of a closure share the same code pointer.
+func measureID(m Measure) int {
+	switch reflect.ValueOf(m).Pointer() {
+	case reflect.ValueOf(MeasureRunes).Pointer():
+		return measureRunes
+	case reflect.ValueOf(MeasureUnicode).Pointer():
+		return measureUnicode
+	default:
+		return -1
+	}
+}
+
+// width returns the maximum width of the lines measured with m.
+func (c *widthCache) width(m Measure, lines []string) int {
+	id := measureID(m)
+	if id < 0 {
+		return linesWidth(m, lines)
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if !equalLines(c.lines, lines) {
+		c.lines = append(c.lines[:0], lines...)
+		c.valid = [numMeasures]bool{}
+	}
+	if !c.valid[id] {
+		c.widths[id] = linesWidth(m, lines)
+		c.valid[id] = true
+	}
+	return c.widths[id]
+}
+
+// equalLines tests if the line arrays are equal.
+func equalLines(a, b []string) bool {
+	if a == nil || len(a) != len(b) {
+		return false
+	}
+	for idx, l := range a {
+		if l != b[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// linesWidth returns the maximum width of the lines.
+func linesWidth(m Measure, lines []string) int {
+	var max int
+	for _, l := range lines {
+		w := m(l)
+		if w > max {
+			max = w
+		}
+	}
+	return max
 }
 
 // NewLines creates a new Lines data from the argument string. The
@@ -88,16 +218,18 @@ func NewText(str string) *Lines {
 	}
 }
 
+// NewWrapped creates a new Lines data from the argument text. The
+// text is word-wrapped so that the lines are at most width runes
+// long. Words longer than width are placed on their own lines and the
+// newline characters in the text start new lines.
+func NewWrapped(text string, width int) *Lines {
+	return NewLinesData(wrap(MeasureRunes, strings.TrimRight(text, "\n"),
+		width))
+}
+
 // Width implements the Data.Width().
 func (lines *Lines) Width(m Measure) int {
-	var max int
-	for _, l := range lines.Lines {
-		w := m(l)
-		if w > max {
-			max = w
-		}
-	}
-	return max
+	return lines.cache.width(m, lines.Lines)
 }
 
 // Height implements the Data.Height().
@@ -121,25 +253,43 @@ func (lines *Lines) String() string {
 // rendering width.
 func NewSlice(maxWidth int) *Slice {
 	return &Slice{
-		maxWidth: maxWidth,
+		maxWidth:  maxWidth,
+		separator: " ",
 	}
 }
 
 // Slice implements the Data interface for an array of Data elements.
 type Slice struct {
-	maxWidth int
-	height   int
-	content  []Data
-	lines    []string
+	maxWidth  int
+	separator string
+	height    int
+	content   []Data
+	lines     []string
+	mu        sync.Mutex
+	cache     widthCache
+}
+
+// SetSeparator sets the separator between the elements rendered on
+// the same line. The default separator is a space character.
+func (arr *Slice) SetSeparator(separator string) *Slice {
+	arr.separator = separator
+	arr.invalidate()
+	return arr
 }
 
 func (arr *Slice) addLine(line string) {
 	arr.lines = append(arr.lines, line)
 }
 
-func (arr *Slice) layout() {
+// layout lays out the array elements into lines and returns the
+// lines. The layout is computed once and it is guarded with a mutex so
+// that the same Slice can be rendered concurrently.
+func (arr *Slice) layout() []string {
+	arr.mu.Lock()
+	defer arr.mu.Unlock()
+
 	if len(arr.lines) > 0 {
-		return
+		return arr.lines
 	}
 	var line string
 	for _, c := range arr.content {
@@ -159,8 +309,8 @@ func (arr *Slice) layout() {
 			l := c.Content(0)
 			if len(line) == 0 {
 				line = l
-			} else if len(line)+len(l) <= arr.maxWidth {
-				line += " "
+			} else if len(line)+len(arr.separator)+len(l) <= arr.maxWidth {
+				line += arr.separator
 				line += l
 			} else {
 				arr.addLine(line)
@@ -171,48 +321,267 @@ func (arr *Slice) layout() {
 	if len(line) > 0 {
 		arr.addLine(line)
 	}
+	return arr.lines
 }
 
 // Append adds data to the array.
 func (arr *Slice) Append(data Data) {
 	arr.content = append(arr.content, data)
+	arr.invalidate()
+}
+
+// invalidate clears the cached layout.
+func (arr *Slice) invalidate() {
+	arr.mu.Lock()
+	arr.lines = nil
+	arr.mu.Unlock()
 }
 
 // Width implements the Data.Width().
 func (arr *Slice) Width(m Measure) int {
-	arr.layout()
-
-	var max int
-	for _, l := range arr.lines {
-		w := m(l)
-		if w > max {
-			max = w
-		}
-	}
-	return max
+	return arr.cache.width(m, arr.layout())
 }
 
 // Height implements the Data.Height().
 func (arr *Slice) Height() int {
-	arr.layout()
-	return len(arr.lines)
+	return len(arr.layout())
 }
 
 // Content implements the Data.Content().
 func (arr *Slice) Content(row int) string {
-	if row < len(arr.lines) {
-		return arr.lines[row]
+	lines := arr.layout()
+	if row < len(lines) {
+		return lines[row]
 	}
 	return ""
 }
 
 func (arr *Slice) String() string {
-	result := "["
+	var sb strings.Builder
+	sb.WriteByte('[')
 	for idx, c := range arr.content {
 		if idx > 0 {
-			result += ","
+			sb.WriteByte(',')
+		}
+		sb.WriteString(c.String())
+	}
+	sb.WriteByte(']')
+	return sb.String()
+}
+
+// wrap splits the text into lines at word boundaries so that the
+// lines are at most width wide, measured with m. Words longer than
+// width are placed on their own lines.
+func wrap(m Measure, text string, width int) []string {
+	var lines []string
+	for _, paragraph := range strings.Split(text, "\n") {
+		var line string
+		for _, word := range strings.Fields(paragraph) {
+			if len(line) == 0 {
+				line = word
+			} else if m(line)+m(" ")+m(word) <= width {
+				line += " " + word
+			} else {
+				lines = append(lines, line)
+				line = word
+			}
 		}
-		result += c.String()
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+// Null implements the Data interface for missing values. Unlike the
+// empty strings, the null values are rendered as the tabulator
+// placeholder, or as their label if the tabulator does not have a
+// placeholder, in the terminal styles, as empty fields in the CSV
+// output, and as null in the JSON output.
+type Null struct {
+	label string
+}
+
+// NewNull creates a new null value with the argument label.
+func NewNull(label string) *Null {
+	return &Null{
+		label: label,
+	}
+}
+
+// Width implements the Data.Width().
+func (n *Null) Width(m Measure) int {
+	return m(n.label)
+}
+
+// Height implements the Data.Height().
+func (n *Null) Height() int {
+	return 1
+}
+
+// Content implements the Data.Content().
+func (n *Null) Content(row int) string {
+	if row > 0 {
+		return ""
+	}
+	return n.label
+}
+
+func (n *Null) String() string {
+	return n.label
+}
+
+// ErrorWidth specifies the maximum width the error messages request
+// from their columns. The messages are wrapped at the column width
+// when the table is rendered.
+const ErrorWidth = 40
+
+// Error implements the Data interface for error values. The error
+// message is wrapped into lines and rendered with the FmtRed format.
+type Error struct {
+	Err   error
+	text  string
+	lines *Lines
+}
+
+// NewError creates a new Error data for the argument error.
+func NewError(err error) *Error {
+	msg := nilLabel
+	if err != nil {
+		msg = err.Error()
+	}
+	return &Error{
+		Err:   err,
+		text:  "Error: " + msg,
+		lines: NewWrapped("Error: "+msg, ErrorWidth),
+	}
+}
+
+// wrap returns the error with its message wrapped at the width,
+// measured with m.
+func (e *Error) wrap(m Measure, width int) *Error {
+	return &Error{
+		Err:   e.Err,
+		text:  e.text,
+		lines: NewLinesData(wrap(m, e.text, width)),
 	}
-	return result + "]"
+}
+
+// Format returns the error cell format.
+func (e *Error) Format() Format {
+	return FmtRed
+}
+
+// Width implements the Data.Width(). The width is the width of the
+// message wrapped at ErrorWidth.
+func (e *Error) Width(m Measure) int {
+	return linesWidth(m, wrap(m, e.text, ErrorWidth))
+}
+
+// Height implements the Data.Height().
+func (e *Error) Height() int {
+	return e.lines.Height()
+}
+
+// Content implements the Data.Content().
+func (e *Error) Content(row int) string {
+	return e.lines.Content(row)
+}
+
+func (e *Error) String() string {
+	if e.Err == nil {
+		return nilLabel
+	}
+	return e.Err.Error()
+}
+
+// ID implements the Data interface for long identifiers, such as
+// UUIDs and digests. The ID is rendered as a shortened prefix with an
+// ellipsis. The full value is used in the wide rendering mode and in
+// the CSV and JSON output.
+type ID struct {
+	full  string
+	short string
+}
+
+// NewID creates a new ID data which shows the shown first characters
+// of the full ID.
+func NewID(full string, shown int) *ID {
+	short := full
+	runes := []rune(full)
+	if shown >= 0 && len(runes) > shown {
+		short = string(runes[:shown]) + "\u2026"
+	}
+	return &ID{
+		full:  full,
+		short: short,
+	}
+}
+
+// Wide returns the full ID as Data.
+func (id *ID) Wide() Data {
+	return NewText(id.full)
+}
+
+// Width implements the Data.Width().
+func (id *ID) Width(m Measure) int {
+	return m(id.short)
+}
+
+// Height implements the Data.Height().
+func (id *ID) Height() int {
+	return 1
+}
+
+// Content implements the Data.Content().
+func (id *ID) Content(row int) string {
+	if row > 0 {
+		return ""
+	}
+	return id.short
+}
+
+func (id *ID) String() string {
+	return id.full
+}
+
+// Lazy implements the Data interface for values which are computed
+// when the data is first used, typically when the table is printed.
+// The computed value is cached so the function is called at most
+// once.
+type Lazy struct {
+	fn    func() string
+	once  sync.Once
+	lines *Lines
+}
+
+// NewLazy creates a new Lazy data with the value function fn.
+func NewLazy(fn func() string) *Lazy {
+	return &Lazy{
+		fn: fn,
+	}
+}
+
+func (lazy *Lazy) data() *Lines {
+	lazy.once.Do(func() {
+		lazy.lines = NewLines(lazy.fn())
+	})
+	return lazy.lines
+}
+
+// Width implements the Data.Width().
+func (lazy *Lazy) Width(m Measure) int {
+	return lazy.data().Width(m)
+}
+
+// Height implements the Data.Height().
+func (lazy *Lazy) Height() int {
+	return lazy.data().Height()
+}
+
+// Content implements the Data.Content().
+func (lazy *Lazy) Content(row int) string {
+	return lazy.data().Content(row)
+}
+
+func (lazy *Lazy) String() string {
+	return lazy.data().String()
 }
@@ -0,0 +1,52 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+// Package-level defaults. These are consulted by New and NewDefault
+// when creating new tabulators. The defaults are not protected
+// against concurrent modification so they should be set during the
+// program initialization.
+var (
+	defaultStyle    = Unicode
+	defaultPadding  = 2
+	defaultMeasure  = MeasureUnicode
+	defaultNoColors = false
+)
+
+// SetDefaultStyle sets the style used by NewDefault.
+func SetDefaultStyle(style Style) {
+	defaultStyle = style
+}
+
+// DefaultStyle returns the style used by NewDefault.
+func DefaultStyle() Style {
+	return defaultStyle
+}
+
+// SetDefaultPadding sets the default column padding. The padding is
+// used in all styles which draw vertical borders around the columns.
+func SetDefaultPadding(padding int) {
+	defaultPadding = padding
+}
+
+// SetDefaultMeasure sets the default column width measurement
+// function.
+func SetDefaultMeasure(m Measure) {
+	defaultMeasure = m
+}
+
+// SetDefaultColors enables or disables VT100 colors and text
+// formatting in new tabulators.
+func SetDefaultColors(enabled bool) {
+	defaultNoColors = !enabled
+}
+
+// NewDefault creates a new tabulate object with the default rendering
+// style.
+func NewDefault() *Tabulate {
+	return New(defaultStyle)
+}
@@ -0,0 +1,44 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaults(t *testing.T) {
+	defer func() {
+		SetDefaultStyle(Unicode)
+		SetDefaultPadding(2)
+		SetDefaultMeasure(MeasureUnicode)
+		SetDefaultColors(true)
+	}()
+
+	SetDefaultStyle(ASCII)
+	SetDefaultPadding(4)
+	SetDefaultColors(false)
+
+	tab := NewDefault()
+	tab.Header("Year").SetFormat(FmtBold)
+	tab.Row().Column("2018")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +--------+
+        |  Year  |
+        +--------+
+        |  2018  |
+        +--------+
+`
+	match(t, sb.String(), expected, "TestDefaults")
+
+	if New(Simple).Padding != 0 {
+		t.Errorf("default padding applied to Simple style")
+	}
+}
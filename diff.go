@@ -0,0 +1,104 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+// Diff compares the data rows of the tables a and b and returns a
+// table describing their differences. The rows are matched by the
+// contents of their first columns. The result table has the style of
+// the table b and its headers, prefixed with a marker column. The
+// column settings of the table b, such as totals and row numbers, are
+// not copied. The added rows are marked with "+" and rendered with
+// FmtGreen, the removed rows with "-" and FmtRed, and the changed rows
+// with "~". The changed cells of the changed rows show the old and the
+// new value and they are rendered with FmtYellow. The result lists the
+// rows in the order of the table b, followed by the removed rows.
+func Diff(a, b *Tabulate) *Tabulate {
+	result := New(b.Style())
+	if len(b.Headers) > 0 {
+		result.HeaderData(NewLines(""))
+		for _, hdr := range b.Headers {
+			result.HeaderData(hdr.Data).SetAlign(hdr.Align)
+		}
+	}
+
+	numCols := a.numColumns()
+	if n := b.numColumns(); n > numCols {
+		numCols = n
+	}
+
+	// Index the rows of the table a by their keys.
+	keys := make(map[string][]int)
+	for idx, row := range a.Rows {
+		key := cellString(row, 0)
+		keys[key] = append(keys[key], idx)
+	}
+	matched := make([]bool, len(a.Rows))
+
+	for _, brow := range b.Rows {
+		key := cellString(brow, 0)
+		indices := keys[key]
+		if len(indices) == 0 {
+			diffRow(result, "+", brow, numCols, FmtGreen)
+			continue
+		}
+		arow := a.Rows[indices[0]]
+		matched[indices[0]] = true
+		keys[key] = indices[1:]
+
+		var changed bool
+		for idx := 0; idx < numCols; idx++ {
+			if cellString(arow, idx) != cellString(brow, idx) {
+				changed = true
+				break
+			}
+		}
+		if !changed {
+			diffRow(result, "", brow, numCols, FmtNone)
+			continue
+		}
+		row := result.Row()
+		row.Column("~")
+		for idx := 0; idx < numCols; idx++ {
+			av := cellString(arow, idx)
+			bv := cellString(brow, idx)
+			if av == bv {
+				row.Column(bv)
+			} else {
+				row.Column(av + " -> " + bv).SetFormat(FmtYellow)
+			}
+		}
+	}
+	for idx, arow := range a.Rows {
+		if !matched[idx] {
+			diffRow(result, "-", arow, numCols, FmtRed)
+		}
+	}
+	return result
+}
+
+// diffRow adds the row to the diff table with the argument marker
+// and format.
+func diffRow(t *Tabulate, marker string, r *Row, numCols int,
+	format Format) {
+
+	row := t.Row()
+	row.Column(marker).SetFormat(format)
+	for idx := 0; idx < numCols; idx++ {
+		row.Column(cellString(r, idx)).SetFormat(format)
+	}
+}
+
+// cellString returns the string value of the cell starting at the
+// table column idx. The function returns an empty string if the row
+// does not have the cell.
+func cellString(r *Row, idx int) string {
+	col := r.cell(idx)
+	if col == nil || col.Data == nil {
+		return ""
+	}
+	return col.Data.String()
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDiff(t *testing.T) {
+	a := New(ASCII)
+	a.SetHeaders("User", "Quota", "Used")
+	a.AddRow("alice", 100, 40)
+	a.AddRow("bob", 100, 90)
+	a.AddRow("carol", 50, 10)
+
+	b := New(ASCII)
+	b.SetHeaders("User", "Quota", "Used")
+	b.AddRow("alice", 100, 40)
+	b.AddRow("bob", 200, 95)
+	b.AddRow("dave", 10, 1)
+
+	diff := Diff(a, b)
+	diff.NoColors = true
+
+	var sb strings.Builder
+	diff.Print(&sb)
+	match(t, sb.String(), `
+        +---+-------+------------+----------+
+        |   | User  | Quota      | Used     |
+        +---+-------+------------+----------+
+        |   | alice | 100        | 40       |
+        | ~ | bob   | 100 -> 200 | 90 -> 95 |
+        | + | dave  | 10         | 1        |
+        | - | carol | 50         | 10       |
+        +---+-------+------------+----------+
+`, "TestDiff")
+
+	if diff.At(2, 0).Format != FmtGreen {
+		t.Errorf("TestDiff: added row format %v", diff.At(2, 0).Format)
+	}
+	if diff.At(3, 0).Format != FmtRed {
+		t.Errorf("TestDiff: removed row format %v", diff.At(3, 0).Format)
+	}
+	if diff.At(1, 2).Format != FmtYellow {
+		t.Errorf("TestDiff: changed cell format %v", diff.At(1, 2).Format)
+	}
+
+	// The column settings of the table b are not inherited.
+	b.SetAutoTotals(1)
+	b.SetRowNumbers(1, "#")
+	diff = Diff(a, b)
+	diff.NoColors = true
+	sb.Reset()
+	diff.Print(&sb)
+	match(t, sb.String(), `
+        +---+-------+------------+----------+
+        |   | User  | Quota      | Used     |
+        +---+-------+------------+----------+
+        |   | alice | 100        | 40       |
+        | ~ | bob   | 100 -> 200 | 90 -> 95 |
+        | + | dave  | 10         | 1        |
+        | - | carol | 50         | 10       |
+        +---+-------+------------+----------+
+`, "TestDiff settings")
+}
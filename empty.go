@@ -0,0 +1,28 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+// SetEmptyText sets the message which is rendered when the table has
+// headers but no data rows. The message is rendered as a centered row
+// spanning all columns. The message is not rendered in the
+// machine-readable output formats. The empty text disables the
+// message.
+func (t *Tabulate) SetEmptyText(text string) {
+	t.emptyText = text
+	t.asData = nil
+}
+
+// emptied creates a new tabulator which has the empty text message
+// row. The headers are shared with this tabulator.
+func (t *Tabulate) emptied() *Tabulate {
+	view := t.derive()
+	view.emptyText = ""
+
+	row := view.Row()
+	row.SpanColumn(t.emptyText, len(t.Headers)).SetAlign(MC)
+	return view
+}
@@ -0,0 +1,55 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"testing"
+)
+
+func TestEmptyText(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.Header("Value")
+	tab.Header("Description")
+	tab.SetEmptyText("no results")
+
+	match(t, tab.String(), `
+        +------+-------+-------------+
+        | Name | Value | Description |
+        +------+-------+-------------+
+        |         no results         |
+        +------+-------+-------------+
+`, "TestEmptyText")
+
+	match(t, tab.Render(CSV), `
+        Name,Value,Description
+`, "TestEmptyText CSV")
+
+	tab.AddRow("a", 1, "first")
+	match(t, tab.String(), `
+        +------+-------+-------------+
+        | Name | Value | Description |
+        +------+-------+-------------+
+        | a    | 1     | first       |
+        +------+-------+-------------+
+`, "TestEmptyText rows")
+}
+
+func TestEmptyTextWide(t *testing.T) {
+	tab := New(Unicode)
+	tab.Header("A")
+	tab.Header("B")
+	tab.SetEmptyText("nothing to show")
+
+	match(t, tab.String(), `
+        ┏━━━┳━━━━━━━━━━━━━┓
+        ┃ A ┃ B           ┃
+        ┡━━━╇━━━━━━━━━━━━━┩
+        │ nothing to show │
+        └───┴─────────────┘
+`, "TestEmptyTextWide")
+}
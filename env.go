@@ -0,0 +1,48 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"io"
+	"strings"
+)
+
+// outputEnv is the Output function of the Env style. Each data row is
+// printed as a KEY=value line where the key is the first column and
+// the value is the rest of the columns, separated by spaces. The keys
+// are converted into valid shell identifiers and the values which
+// contain special characters are single-quoted so that the output can
+// be safely sourced by the shell.
+func outputEnv(t *Tabulate, o io.Writer) {
+	sep := t.separator
+	if len(sep) == 0 {
+		sep = "="
+	}
+	var sb strings.Builder
+	for _, row := range t.Rows {
+		if row.group || len(row.Columns) == 0 {
+			continue
+		}
+		var values []string
+		for idx, col := range row.Columns {
+			if idx == 0 {
+				continue
+			}
+			values = append(values, t.shellText(col))
+		}
+		sb.Reset()
+		sb.WriteString(shellName(t.shellText(row.Columns[0])))
+		sb.WriteString(sep)
+		if value := strings.Join(values, " "); len(value) > 0 {
+			sb.WriteString(shellQuote(value))
+		}
+		sb.WriteByte('\n')
+		if _, err := io.WriteString(o, sb.String()); err != nil {
+			return
+		}
+	}
+}
@@ -0,0 +1,67 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"testing"
+)
+
+func TestEnv(t *testing.T) {
+	tab := New(Env)
+	tab.Header("Key")
+	tab.Header("Value")
+	tab.AddRow("HOME", "/home/user")
+	tab.AddRow("GREETING", "hello world")
+	tab.AddRow("QUOTE", `say "hi"`)
+	tab.AddRow("EMPTY", "")
+	tab.AddRow("my-key", "$(id) `id` it's")
+
+	match(t, tab.Render(Env), `
+        HOME=/home/user
+        GREETING='hello world'
+        QUOTE='say "hi"'
+        EMPTY=
+        my_key='$(id) `+"`id`"+` it'\''s'
+`, "TestEnv")
+
+	tab.SetSeparator(": ")
+	match(t, tab.Render(Env), `
+        HOME: /home/user
+        GREETING: 'hello world'
+        QUOTE: 'say "hi"'
+        EMPTY: 
+        my_key: '$(id) `+"`id`"+` it'\''s'
+`, "TestEnv separator")
+}
+
+func TestColonSeparator(t *testing.T) {
+	tab := New(Colon)
+	tab.Header("Key")
+	tab.Header("Value")
+	tab.AddRow("name", "alpha")
+	tab.AddRow("id", 42)
+
+	tab.SetSeparator(" = ")
+	match(t, tab.String(), `
+        Key  = Value
+        name = alpha
+        id   = 42
+`, "TestColonSeparator")
+
+	match(t, tab.Render(Colon), `
+        Key  = Value
+        name = alpha
+        id   = 42
+`, "TestColonSeparator Render")
+
+	tab.SetSeparator("")
+	match(t, tab.String(), `
+        Key  : Value
+        name : alpha
+        id   : 42
+`, "TestColonSeparator default")
+}
@@ -0,0 +1,113 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"io"
+	"os"
+	"strings"
+)
+
+// CharsetDetector tests if the output writer supports all characters
+// of the argument string.
+type CharsetDetector func(o io.Writer, chars string) bool
+
+// DetectCharset is the default charset detector. If the writer is a
+// Windows console, all characters are supported if the console uses
+// the UTF-8 output code page. Otherwise the function assumes that all
+// characters are supported if the locale environment variables
+// specify UTF-8 encoding. In all other cases only ASCII characters
+// are supported.
+func DetectCharset(o io.Writer, chars string) bool {
+	if utf8, ok := consoleUTF8(o); ok {
+		return utf8 || isASCII(chars)
+	}
+	for _, env := range []string{"LC_ALL", "LC_CTYPE", "LANG"} {
+		val := os.Getenv(env)
+		if len(val) == 0 {
+			continue
+		}
+		val = strings.ToLower(val)
+		if strings.Contains(val, "utf-8") || strings.Contains(val, "utf8") {
+			return true
+		}
+		break
+	}
+	return isASCII(chars)
+}
+
+// isASCII tests if the string contains only ASCII characters.
+func isASCII(s string) bool {
+	for _, r := range s {
+		if r >= 0x80 {
+			return false
+		}
+	}
+	return true
+}
+
+// SetFallbacks sets the style degradation ladder. When the table is
+// printed, it is rendered with the first style whose border
+// characters the output supports. The selected style is used only for
+// the current print and the table's own style and style attributes
+// are not modified. The support is tested with the tabulator's charset
+// detector which defaults to DetectCharset. In the deterministic mode
+// the first style is used without detection, unless the tabulator has
+// an explicit charset detector. The fallbacks are not applied when the
+// table is rendered with an explicit style with Render.
+func (t *Tabulate) SetFallbacks(styles ...Style) {
+	t.fallbacks = styles
+}
+
+// SetCharsetDetector sets the charset detector for selecting the
+// style from the fallbacks.
+func (t *Tabulate) SetCharsetDetector(detector CharsetDetector) {
+	t.detector = detector
+}
+
+// fallbackStyle selects the table style for the writer from the
+// fallbacks. The function returns false if the table does not have
+// fallbacks or if the table is rendered with an explicit style.
+func (t *Tabulate) fallbackStyle(o io.Writer) (Style, bool) {
+	if len(t.fallbacks) == 0 || t.fixedStyle {
+		return t.style, false
+	}
+	detector := t.detector
+	if detector == nil {
+		if t.Deterministic {
+			detector = func(o io.Writer, chars string) bool {
+				return true
+			}
+		} else {
+			detector = DetectCharset
+		}
+	}
+	for _, s := range t.fallbacks {
+		if detector(o, borderChars(borders[s])) {
+			return s, true
+		}
+	}
+	return t.fallbacks[len(t.fallbacks)-1], true
+}
+
+// borderChars returns all drawing elements of the borders.
+func borderChars(b Borders) string {
+	var sb strings.Builder
+	for _, border := range []Border{b.Header, b.Body} {
+		for _, s := range []string{
+			border.HT, border.HM, border.HB,
+			border.VL, border.VM, border.VR,
+			border.TL, border.TM, border.TR,
+			border.ML, border.MM, border.MR,
+			border.BL, border.BM, border.BR,
+			border.VG, border.TG, border.MG, border.BG,
+		} {
+			sb.WriteString(s)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,91 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestFallbacks(t *testing.T) {
+	tab := tabulate(New(Plain), TL, `Year,Income
+2018,100`)
+	tab.SetFallbacks(Unicode, ASCII, Plain)
+
+	ascii := func(o io.Writer, chars string) bool {
+		for _, r := range chars {
+			if r >= 0x80 {
+				return false
+			}
+		}
+		return true
+	}
+	tab.SetCharsetDetector(ascii)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +------+--------+
+        | Year | Income |
+        +------+--------+
+        | 2018 | 100    |
+        +------+--------+
+`
+	match(t, sb.String(), expected, "TestFallbacks ASCII")
+
+	tab.SetCharsetDetector(func(o io.Writer, chars string) bool {
+		return true
+	})
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        ┏━━━━━━┳━━━━━━━━┓
+        ┃ Year ┃ Income ┃
+        ┡━━━━━━╇━━━━━━━━┩
+        │ 2018 │ 100    │
+        └──────┴────────┘
+`
+	match(t, sb.String(), expected, "TestFallbacks Unicode")
+}
+
+func TestDetectCharset(t *testing.T) {
+	t.Setenv("LC_ALL", "")
+	t.Setenv("LC_CTYPE", "")
+	t.Setenv("LANG", "en_US.UTF-8")
+	if !DetectCharset(nil, "─") {
+		t.Errorf("UTF-8 locale does not support Unicode")
+	}
+	t.Setenv("LANG", "C")
+	if DetectCharset(nil, "─") {
+		t.Errorf("C locale supports Unicode")
+	}
+	if !DetectCharset(nil, "+-|") {
+		t.Errorf("C locale does not support ASCII")
+	}
+}
+
+func TestFallbacksKeepStyle(t *testing.T) {
+	tab := tabulate(New(Plain), TL, `Year,Income
+2018,100`)
+	tab.Padding = 4
+	tab.SetFallbacks(Unicode, ASCII)
+	tab.SetCharsetDetector(func(o io.Writer, chars string) bool {
+		return true
+	})
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	if tab.Style() != Plain || tab.Padding != 4 {
+		t.Errorf("TestFallbacksKeepStyle: style %v, padding %d",
+			tab.Style(), tab.Padding)
+	}
+	match(t, tab.Render(CSV), `
+        Year,Income
+        2018,100
+`, "TestFallbacksKeepStyle CSV")
+}
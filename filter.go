@@ -0,0 +1,145 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"bytes"
+	"io"
+)
+
+// LineFilter processes an output line. The line does not contain the
+// terminating newline character.
+type LineFilter func(line string) string
+
+// SetLineFilter sets the line filter which is applied to each line
+// the tabulator prints.
+func (t *Tabulate) SetLineFilter(filter LineFilter) {
+	t.lineFilter = filter
+}
+
+// lineWriter implements io.Writer which applies a line filter to the
+// written lines.
+type lineWriter struct {
+	w      io.Writer
+	filter LineFilter
+	buf    []byte
+	err    error
+}
+
+func (lw *lineWriter) Write(p []byte) (int, error) {
+	if lw.err != nil {
+		return 0, lw.err
+	}
+	lw.buf = append(lw.buf, p...)
+	for {
+		idx := bytes.IndexByte(lw.buf, '\n')
+		if idx < 0 {
+			break
+		}
+		line := lw.filter(string(lw.buf[:idx]))
+		lw.buf = lw.buf[idx+1:]
+		if _, lw.err = io.WriteString(lw.w, line+"\n"); lw.err != nil {
+			return 0, lw.err
+		}
+	}
+	return len(p), nil
+}
+
+func (lw *lineWriter) fail(err error) bool {
+	return fail(lw.w, err)
+}
+
+// Flush writes the pending unterminated line.
+func (lw *lineWriter) Flush() error {
+	if lw.err != nil || len(lw.buf) == 0 {
+		return lw.err
+	}
+	line := lw.filter(string(lw.buf))
+	lw.buf = nil
+	_, lw.err = io.WriteString(lw.w, line)
+	return lw.err
+}
+
+// bufferedWriterSize specifies the size after which bufferedWriter
+// writes its complete lines to the underlying writer.
+const bufferedWriterSize = 4096
+
+// bufferedWriter implements io.Writer which buffers the output and
+// writes it to the underlying writer in chunks of complete lines.
+type bufferedWriter struct {
+	w   io.Writer
+	buf []byte
+	err error
+}
+
+func (bw *bufferedWriter) Write(p []byte) (int, error) {
+	if bw.err != nil {
+		return 0, bw.err
+	}
+	bw.buf = append(bw.buf, p...)
+	if len(bw.buf) >= bufferedWriterSize {
+		bw.flushLines()
+		if bw.err != nil {
+			return 0, bw.err
+		}
+	}
+	return len(p), nil
+}
+
+// WriteString implements io.StringWriter. It avoids the string to
+// byte slice conversions of the many small writes of the renderer.
+func (bw *bufferedWriter) WriteString(s string) (int, error) {
+	if bw.err != nil {
+		return 0, bw.err
+	}
+	bw.buf = append(bw.buf, s...)
+	if len(bw.buf) >= bufferedWriterSize {
+		bw.flushLines()
+		if bw.err != nil {
+			return 0, bw.err
+		}
+	}
+	return len(s), nil
+}
+
+// flushLines writes all buffered complete lines to the underlying
+// writer.
+func (bw *bufferedWriter) flushLines() {
+	idx := bytes.LastIndexByte(bw.buf, '\n')
+	if idx < 0 {
+		return
+	}
+	_, bw.err = bw.w.Write(bw.buf[:idx+1])
+	bw.buf = append(bw.buf[:0], bw.buf[idx+1:]...)
+}
+
+func (bw *bufferedWriter) fail(err error) bool {
+	return fail(bw.w, err)
+}
+
+// Flush writes all buffered data to the underlying writer.
+func (bw *bufferedWriter) Flush() error {
+	if bw.err != nil || len(bw.buf) == 0 {
+		return bw.err
+	}
+	_, bw.err = bw.w.Write(bw.buf)
+	bw.buf = bw.buf[:0]
+	return bw.err
+}
+
+// writeln writes the string and a newline to the writer.
+func writeln(o io.Writer, s string) {
+	io.WriteString(o, s)
+	io.WriteString(o, "\n")
+}
+
+// writeRepeat writes the string n times to the writer.
+func writeRepeat(o io.Writer, s string, n int) {
+	for i := 0; i < n; i++ {
+		io.WriteString(o, s)
+	}
+}
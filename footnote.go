@@ -0,0 +1,90 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+	"io"
+)
+
+// footnotes returns the descriptions of the visible header columns in
+// their column order. The function returns nil for the
+// machine-readable output formats and for the custom outputs.
+func (t *Tabulate) footnotes() []string {
+	if t.TrimColumns || t.customOutput() {
+		return nil
+	}
+	var result []string
+	for idx, hdr := range t.Headers {
+		if len(hdr.Description) > 0 && t.visible(idx) {
+			result = append(result, hdr.Description)
+		}
+	}
+	return result
+}
+
+// visible tests if the table column idx is rendered.
+func (t *Tabulate) visible(idx int) bool {
+	columns := t.visibleColumns()
+	if columns == nil {
+		return true
+	}
+	for _, c := range columns {
+		if c == idx {
+			return true
+		}
+	}
+	return false
+}
+
+// printAnnotated prints the table with the footnote markers of the
+// notes. The notes are the table footnotes computed by the caller.
+func (t *Tabulate) printAnnotated(o io.Writer, notes []string) {
+	if len(notes) > 0 {
+		t.annotated().print(o)
+	} else {
+		t.print(o)
+	}
+}
+
+// annotated creates a new tabulator which has the footnote markers
+// appended to the labels of the described header columns. The header
+// copies don't have descriptions and the cells are shared with this
+// tabulator.
+func (t *Tabulate) annotated() *Tabulate {
+	view := t.derive()
+
+	view.Headers = nil
+	var n int
+	for idx, hdr := range t.Headers {
+		if len(hdr.Description) == 0 {
+			view.Headers = append(view.Headers, hdr)
+			continue
+		}
+		h := *hdr
+		h.Description = ""
+		if t.visible(idx) {
+			n++
+			var lines []string
+			for line := 0; line < hdr.Height(); line++ {
+				lines = append(lines, hdr.Content(line))
+			}
+			if len(lines) == 0 {
+				lines = append(lines, "")
+			}
+			lines[len(lines)-1] += fmt.Sprintf("[%d]", n)
+			if h.Format == FmtNone {
+				if f, ok := hdr.Data.(formatter); ok {
+					h.Format = f.Format()
+				}
+			}
+			h.Data = NewLinesData(lines)
+		}
+		view.Headers = append(view.Headers, &h)
+	}
+	return view
+}
@@ -0,0 +1,82 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDescription(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Host")
+	tab.Header("CPU").SetAlign(TR).SetDescription("5-min average")
+	tab.Header("Mem").SetAlign(TR).SetDescription("Resident set size")
+	tab.AddRow("alpha", "12%", "1.5G")
+	tab.AddRow("beta", "3%", "512M")
+
+	match(t, tab.String(), `
+        +-------+--------+--------+
+        | Host  | CPU[1] | Mem[2] |
+        +-------+--------+--------+
+        | alpha |    12% |   1.5G |
+        | beta  |     3% |   512M |
+        +-------+--------+--------+
+        [1] 5-min average
+        [2] Resident set size
+`, "TestDescription")
+
+	match(t, tab.Render(CSV), `
+        Host,CPU,Mem
+        alpha,12%,1.5G
+        beta,3%,512M
+`, "TestDescription CSV")
+
+	tab.HideColumn(1)
+	match(t, tab.Render(ASCII), `
+        +-------+--------+
+        | Host  | Mem[1] |
+        +-------+--------+
+        | alpha |   1.5G |
+        | beta  |   512M |
+        +-------+--------+
+        [1] Resident set size
+`, "TestDescription hidden")
+}
+
+func TestDescriptionHTML(t *testing.T) {
+	tab := New(HTML)
+	tab.Header("CPU").SetDescription(`5-min "average"`)
+	tab.AddRow("12%")
+
+	got := tab.String()
+	if !strings.Contains(got, `<th title="5-min &#34;average&#34;">CPU</th>`) {
+		t.Errorf("TestDescriptionHTML: got\n%s", got)
+	}
+	if strings.Contains(got, "[1]") {
+		t.Errorf("TestDescriptionHTML: footnote in HTML output:\n%s", got)
+	}
+}
+
+func TestDescriptionCompact(t *testing.T) {
+	tab := New(ASCII)
+	tab.SetCompact(true)
+	tab.Header("Host")
+	tab.Header("CPU").SetDescription("5-min average")
+	tab.AddRow("alpha", "12%")
+	tab.AddStrings("beta", "3%")
+
+	match(t, tab.String(), `
+        +-------+--------+
+        | Host  | CPU[1] |
+        +-------+--------+
+        | alpha | 12%    |
+        | beta  | 3%     |
+        +-------+--------+
+        [1] 5-min average
+`, "TestDescriptionCompact")
+}
@@ -14,8 +14,18 @@ const (
 	FmtNone Format = iota
 	FmtBold
 	FmtItalic
+	FmtRed
+	FmtGreen
+	FmtYellow
 )
 
+// formatter is implemented by Data types which specify their own
+// default format. The data format is used if the column does not
+// have an explicit format.
+type formatter interface {
+	Format() Format
+}
+
 // VT100 creates VT100 terminal emulation codes for the agument
 // format.
 func (fmt Format) VT100() string {
@@ -24,6 +34,12 @@ func (fmt Format) VT100() string {
 		return "\x1b[1m"
 	case FmtItalic:
 		return "\x1b[3m"
+	case FmtRed:
+		return "\x1b[31m"
+	case FmtGreen:
+		return "\x1b[32m"
+	case FmtYellow:
+		return "\x1b[33m"
 	default:
 		return "\x1b[m"
 	}
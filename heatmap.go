@@ -0,0 +1,100 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+)
+
+// Palette lists the heatmap colors as "#rrggbb" or "#rgb" hex codes,
+// from the color of the minimum value to the color of the maximum
+// value. The colors between the palette entries are interpolated.
+type Palette []string
+
+// Predefined heatmap palettes.
+var (
+	PaletteGreenRed = Palette{"#1a9850", "#fee08b", "#d73027"}
+	PaletteBlueRed  = Palette{"#2c7bb6", "#abd9e9", "#ffffbf", "#fdae61",
+		"#d7191c"}
+	PaletteGray = Palette{"#303030", "#d0d0d0"}
+)
+
+// heatmap defines the value range and colors of a heatmap column.
+type heatmap struct {
+	min    float64
+	max    float64
+	colors [][3]uint8
+}
+
+// SetHeatmap sets the column heatmap. The background of each numeric
+// data cell of the column is colored with the palette color of its
+// value in the range from min to max. The values outside the range
+// get the colors of the range ends. The heatmap is not rendered if
+// the NoColors is set or if the table is rendered in a
+// machine-readable format. The invalid palette colors are ignored and
+// the palette without valid colors disables the heatmap.
+func (col *Column) SetHeatmap(min, max float64, palette Palette) *Column {
+	hm := &heatmap{
+		min: min,
+		max: max,
+	}
+	for _, hex := range palette {
+		r, g, b, ok := parseHexColor(hex)
+		if ok {
+			hm.colors = append(hm.colors, [3]uint8{r, g, b})
+		}
+	}
+	if len(hm.colors) == 0 {
+		hm = nil
+	}
+	col.heatmap = hm
+	return col
+}
+
+// color returns the background color escape code for the value.
+func (hm *heatmap) color(v float64) string {
+	var pos float64
+	if hm.max > hm.min {
+		pos = (v - hm.min) / (hm.max - hm.min)
+	}
+	if pos < 0 {
+		pos = 0
+	} else if pos > 1 {
+		pos = 1
+	}
+	pos *= float64(len(hm.colors) - 1)
+	i := int(pos)
+	if i >= len(hm.colors)-1 {
+		i = len(hm.colors) - 1
+		pos = float64(i)
+	}
+	c := hm.colors[i]
+	if i+1 < len(hm.colors) {
+		next := hm.colors[i+1]
+		f := pos - float64(i)
+		for ch := range c {
+			c[ch] = uint8(float64(c[ch]) +
+				f*(float64(next[ch])-float64(c[ch])) + 0.5)
+		}
+	}
+	return fmt.Sprintf("\x1b[48;2;%d;%d;%dm", c[0], c[1], c[2])
+}
+
+// heat returns the heatmap background color escape code for the data
+// cell col at the table column idx. The function returns an empty
+// string if the cell is not colored.
+func (t *Tabulate) heat(hdr bool, col *Column, idx int) string {
+	if hdr || t.NoColors || t.TrimColumns || col.span() != 1 ||
+		idx >= len(t.Headers) || t.Headers[idx].heatmap == nil {
+		return ""
+	}
+	v, ok := parseNumber(col.Content(0))
+	if !ok {
+		return ""
+	}
+	return t.Headers[idx].heatmap.color(v)
+}
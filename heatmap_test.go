@@ -0,0 +1,41 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHeatmap(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Host")
+	tab.Header("Load").SetHeatmap(0, 100, Palette{"#000", "#ffffff"})
+	tab.AddRow("a", 0)
+	tab.AddRow("b", 50)
+	tab.AddRow("c", 200)
+	tab.AddRow("d", "n/a")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := " Host  Load \n" +
+		" a    \x1b[48;2;0;0;0m 0    \x1b[m\n" +
+		" b    \x1b[48;2;128;128;128m 50   \x1b[m\n" +
+		" c    \x1b[48;2;255;255;255m 200  \x1b[m\n" +
+		" d     n/a  \n"
+	if sb.String() != expected {
+		t.Errorf("TestHeatmap: got %q, expected %q", sb.String(), expected)
+	}
+
+	if strings.Contains(tab.Render(CSV), "\x1b") {
+		t.Errorf("TestHeatmap: CSV output has escape sequences")
+	}
+	tab.NoColors = true
+	if strings.Contains(tab.Render(Plain), "\x1b") {
+		t.Errorf("TestHeatmap: NoColors output has escape sequences")
+	}
+}
@@ -0,0 +1,82 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"regexp"
+	"strings"
+)
+
+type highlight struct {
+	re     *regexp.Regexp
+	format Format
+}
+
+// Highlight highlights the cell substrings matching the regular
+// expression pattern with the argument format. The highlights are
+// applied at render time and they are not applied if the NoColors is
+// set or if the table is rendered in a machine-readable format. Use
+// regexp.QuoteMeta to highlight literal strings.
+func (t *Tabulate) Highlight(pattern string, format Format) error {
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return err
+	}
+	t.highlights = append(t.highlights, highlight{
+		re:     re,
+		format: format,
+	})
+	return nil
+}
+
+// highlight applies the table highlights to the cell content. The
+// earlier highlights take precedence over the later ones for
+// overlapping matches. The cell format is restored after each
+// highlighted substring.
+func (t *Tabulate) highlight(content string, format Format) string {
+	if len(t.highlights) == 0 || t.NoColors || t.TrimColumns {
+		return content
+	}
+	// The marks hold the highlight index+1 for each content byte.
+	var marks []int
+	for idx, h := range t.highlights {
+		for _, m := range h.re.FindAllStringIndex(content, -1) {
+			if marks == nil {
+				marks = make([]int, len(content))
+			}
+			for i := m[0]; i < m[1]; i++ {
+				if marks[i] == 0 {
+					marks[i] = idx + 1
+				}
+			}
+		}
+	}
+	if marks == nil {
+		return content
+	}
+
+	var sb strings.Builder
+	var start int
+	for start < len(content) {
+		end := start + 1
+		for end < len(content) && marks[end] == marks[start] {
+			end++
+		}
+		if marks[start] == 0 {
+			sb.WriteString(content[start:end])
+		} else {
+			sb.WriteString(t.highlights[marks[start]-1].format.VT100())
+			sb.WriteString(content[start:end])
+			sb.WriteString(FmtNone.VT100())
+			if format != FmtNone {
+				sb.WriteString(format.VT100())
+			}
+		}
+		start = end
+	}
+	return sb.String()
+}
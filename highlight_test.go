@@ -0,0 +1,52 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"regexp"
+	"strings"
+	"testing"
+)
+
+func TestHighlight(t *testing.T) {
+	tab := New(Plain)
+	tab.NoColors = false
+	tab.Header("Host")
+	tab.Header("Status")
+	tab.AddRow("www.example.com", "error: timeout")
+	tab.AddRow("db.example.com", "ok")
+
+	if err := tab.Highlight("error|timeout", FmtRed); err != nil {
+		t.Fatalf("Highlight failed: %v", err)
+	}
+	if err := tab.Highlight(regexp.QuoteMeta("db."), FmtBold); err != nil {
+		t.Fatalf("Highlight failed: %v", err)
+	}
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := " Host             Status         \n" +
+		" www.example.com  \x1b[31merror\x1b[m: \x1b[31mtimeout\x1b[m \n" +
+		" \x1b[1mdb.\x1b[mexample.com   ok             \n"
+	if sb.String() != expected {
+		t.Errorf("TestHighlight: got\n%q\nexpected\n%q", sb.String(), expected)
+	}
+
+	sb.Reset()
+	tab.NoColors = true
+	tab.Print(&sb)
+	expected = " Host             Status         \n" +
+		" www.example.com  error: timeout \n" +
+		" db.example.com   ok             \n"
+	if sb.String() != expected {
+		t.Errorf("TestHighlight: got\n%q\nexpected\n%q", sb.String(), expected)
+	}
+
+	if err := tab.Highlight("(", FmtRed); err == nil {
+		t.Errorf("Highlight accepted invalid pattern")
+	}
+}
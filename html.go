@@ -0,0 +1,91 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+	"html"
+	"io"
+	"strings"
+)
+
+// outputHTML is the Output function of the HTML style. The table is
+// printed as an HTML table element. The multi-line cells are
+// separated with line break elements and the horizontal alignments
+// are set with the text-align style.
+func outputHTML(t *Tabulate, o io.Writer) {
+	t.numeric = t.numericColumns()
+
+	var sb strings.Builder
+	sb.WriteString("<table>\n")
+	if len(t.Headers) > 0 {
+		sb.WriteString("<thead>\n<tr>")
+		for idx, hdr := range t.Headers {
+			t.htmlCell(&sb, "th", hdr, idx)
+		}
+		sb.WriteString("</tr>\n</thead>\n")
+	}
+	if len(t.Rows) > 0 {
+		sb.WriteString("<tbody>\n")
+	}
+	if _, err := io.WriteString(o, sb.String()); err != nil {
+		return
+	}
+	for _, row := range t.Rows {
+		sb.Reset()
+		sb.WriteString("<tr>")
+		var idx int
+		for _, col := range row.Columns {
+			t.htmlCell(&sb, "td", t.fill(col), idx)
+			idx += col.span()
+		}
+		for ; idx < len(t.Headers); idx++ {
+			t.htmlCell(&sb, "td", t.fill(emptyColumn), idx)
+		}
+		sb.WriteString("</tr>\n")
+		if _, err := io.WriteString(o, sb.String()); err != nil {
+			return
+		}
+	}
+	sb.Reset()
+	if len(t.Rows) > 0 {
+		sb.WriteString("</tbody>\n")
+	}
+	sb.WriteString("</table>\n")
+	io.WriteString(o, sb.String())
+}
+
+// htmlCell writes the column idx as an HTML cell element.
+func (t *Tabulate) htmlCell(sb *strings.Builder, elem string, col *Column,
+	idx int) {
+
+	sb.WriteByte('<')
+	sb.WriteString(elem)
+	if col.span() > 1 {
+		fmt.Fprintf(sb, ` colspan="%d"`, col.span())
+	}
+	if len(col.Description) > 0 {
+		fmt.Fprintf(sb, ` title="%s"`, html.EscapeString(col.Description))
+	}
+	switch t.alignAt(col, idx) {
+	case TC, MC, BC:
+		sb.WriteString(` style="text-align:center"`)
+	case TR, MR, BR:
+		sb.WriteString(` style="text-align:right"`)
+	}
+	sb.WriteByte('>')
+	col = t.widen(col)
+	for line := 0; line < col.Height(); line++ {
+		if line > 0 {
+			sb.WriteString("<br>")
+		}
+		sb.WriteString(html.EscapeString(stripANSI(col.Content(line))))
+	}
+	sb.WriteString("</")
+	sb.WriteString(elem)
+	sb.WriteByte('>')
+}
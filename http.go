@@ -0,0 +1,97 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// httpFormats map the Handler format names to the table styles and
+// content types. The text format uses the table's own style.
+var httpFormats = map[string]struct {
+	style       Style
+	contentType string
+}{
+	"html": {HTML, "text/html; charset=utf-8"},
+	"json": {JSON, "application/json"},
+	"csv":  {CSV, "text/csv; charset=utf-8"},
+	"text": {Plain, "text/plain; charset=utf-8"},
+}
+
+// httpMediaTypes map the Accept header media types to the Handler
+// format names.
+var httpMediaTypes = map[string]string{
+	"text/html":        "html",
+	"application/json": "json",
+	"text/csv":         "csv",
+	"text/plain":       "text",
+}
+
+// Handler returns an HTTP handler which serves the table. The output
+// format is selected with the format query parameter, which can be
+// html, json, csv, or text, or with the Accept header. The text
+// format renders the table with its own style without colors. If the
+// request does not specify the format, the table is served as text.
+// If the table can not be rendered in the format, the handler responds
+// with the internal server error status.
+// The handler serializes the table rendering but the table must not
+// be modified while it is being served.
+func Handler(tab *Tabulate) http.Handler {
+	var mu sync.Mutex
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		name := r.URL.Query().Get("format")
+		if len(name) == 0 {
+			name = acceptFormat(r.Header.Get("Accept"))
+		}
+		name = strings.ToLower(name)
+		format, ok := httpFormats[name]
+		if !ok {
+			http.Error(w, fmt.Sprintf("unknown format: %s", name),
+				http.StatusBadRequest)
+			return
+		}
+		var view *Tabulate
+		if name == "text" {
+			view = tab.printView()
+		} else {
+			view = tab.styled(format.style)
+		}
+		view.colorMode = ColorNever
+
+		var sb strings.Builder
+		mu.Lock()
+		_, err := view.Fprint(&sb)
+		mu.Unlock()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", format.contentType)
+		io.WriteString(w, sb.String())
+	})
+}
+
+// acceptFormat returns the format name for the first supported media
+// type of the Accept header. The function returns "text" if the
+// header does not have supported media types.
+func acceptFormat(accept string) string {
+	for _, part := range strings.Split(accept, ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(part))
+		if err != nil {
+			continue
+		}
+		if name, ok := httpMediaTypes[mediaType]; ok {
+			return name
+		}
+	}
+	return "text"
+}
@@ -0,0 +1,125 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandler(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.Header("Count").SetAlign(MR)
+	tab.AddRow("a<b", 42)
+
+	tests := []struct {
+		target      string
+		accept      string
+		status      int
+		contentType string
+		body        string
+	}{
+		{
+			target:      "/",
+			status:      http.StatusOK,
+			contentType: "text/plain; charset=utf-8",
+			body: `+------+-------+
+| Name | Count |
++------+-------+
+| a<b  |    42 |
++------+-------+
+`,
+		},
+		{
+			target:      "/",
+			accept:      "text/html,application/xhtml+xml;q=0.9",
+			status:      http.StatusOK,
+			contentType: "text/html; charset=utf-8",
+			body: `<table>
+<thead>
+<tr><th>Name</th><th style="text-align:right">Count</th></tr>
+</thead>
+<tbody>
+<tr><td>a&lt;b</td><td style="text-align:right">42</td></tr>
+</tbody>
+</table>
+`,
+		},
+		{
+			target:      "/?format=csv",
+			accept:      "text/html",
+			status:      http.StatusOK,
+			contentType: "text/csv; charset=utf-8",
+			body:        "Name,Count\r\na<b,42\r\n",
+		},
+		{
+			target:      "/?format=json",
+			status:      http.StatusOK,
+			contentType: "application/json",
+			body:        `{"a\u003cb":42}` + "\n",
+		},
+		{
+			target:      "/?format=TEXT",
+			status:      http.StatusOK,
+			contentType: "text/plain; charset=utf-8",
+			body: `+------+-------+
+| Name | Count |
++------+-------+
+| a<b  |    42 |
++------+-------+
+`,
+		},
+		{
+			target: "/?format=xml",
+			status: http.StatusBadRequest,
+		},
+	}
+	handler := Handler(tab)
+	for _, test := range tests {
+		req := httptest.NewRequest("GET", test.target, nil)
+		if len(test.accept) > 0 {
+			req.Header.Set("Accept", test.accept)
+		}
+		rec := httptest.NewRecorder()
+		handler.ServeHTTP(rec, req)
+		if rec.Code != test.status {
+			t.Errorf("%s: status %d, expected %d",
+				test.target, rec.Code, test.status)
+			continue
+		}
+		if test.status != http.StatusOK {
+			continue
+		}
+		if ct := rec.Header().Get("Content-Type"); ct != test.contentType {
+			t.Errorf("%s: Content-Type %q, expected %q",
+				test.target, ct, test.contentType)
+		}
+		if rec.Body.String() != test.body {
+			t.Errorf("%s: got\n%s\nexpected\n%s",
+				test.target, rec.Body.String(), test.body)
+		}
+	}
+	if tab.Style() != ASCII {
+		t.Errorf("Handler changed table style to %s", tab.Style())
+	}
+}
+
+func TestHandlerError(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.AddRow("alpha")
+
+	req := httptest.NewRequest("GET", "/?format=json", nil)
+	rec := httptest.NewRecorder()
+	Handler(tab).ServeHTTP(rec, req)
+	if rec.Code != http.StatusInternalServerError {
+		t.Errorf("TestHandlerError: status %d, expected %d",
+			rec.Code, http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,121 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+	"net/netip"
+)
+
+var (
+	_ = Data((&IP{}))
+)
+
+// IP implements the Data interface for IP addresses and network
+// prefixes.
+type IP struct {
+	addr   netip.Addr
+	bits   int
+	padded bool
+}
+
+// NewIP creates a new IP data for the IP address.
+func NewIP(addr netip.Addr) *IP {
+	return &IP{
+		addr: addr,
+		bits: -1,
+	}
+}
+
+// NewPrefix creates a new IP data for the network prefix.
+func NewPrefix(prefix netip.Prefix) *IP {
+	return &IP{
+		addr: prefix.Addr(),
+		bits: prefix.Bits(),
+	}
+}
+
+// SetPadded sets the zero-padding attribute. The padded IPv4
+// addresses have their octets zero-padded to three digits and the
+// padded IPv6 addresses are rendered in their expanded form. This way
+// all addresses of the same family have the same width and they line
+// up in the column.
+func (ip *IP) SetPadded(padded bool) *IP {
+	ip.padded = padded
+	return ip
+}
+
+// Addr returns the IP address.
+func (ip *IP) Addr() netip.Addr {
+	return ip.addr
+}
+
+// Bits returns the prefix length. The function returns -1 if the data
+// is an IP address.
+func (ip *IP) Bits() int {
+	return ip.bits
+}
+
+// Width implements the Data.Width().
+func (ip *IP) Width(m Measure) int {
+	return m(ip.String())
+}
+
+// Height implements the Data.Height().
+func (ip *IP) Height() int {
+	return 1
+}
+
+// Content implements the Data.Content().
+func (ip *IP) Content(row int) string {
+	if row > 0 {
+		return ""
+	}
+	return ip.String()
+}
+
+func (ip *IP) String() string {
+	var str string
+	if !ip.padded {
+		str = ip.addr.String()
+	} else if ip.addr.Is4() {
+		a := ip.addr.As4()
+		str = fmt.Sprintf("%03d.%03d.%03d.%03d", a[0], a[1], a[2], a[3])
+	} else {
+		str = ip.addr.StringExpanded()
+	}
+	if ip.bits < 0 {
+		return str
+	}
+	if !ip.padded {
+		return fmt.Sprintf("%s/%d", str, ip.bits)
+	}
+	if ip.addr.Is4() {
+		return fmt.Sprintf("%s/%02d", str, ip.bits)
+	}
+	return fmt.Sprintf("%s/%03d", str, ip.bits)
+}
+
+// LessIP compares IP data values in their natural order: by address
+// and then by prefix length. The addresses sort before the prefixes
+// of the same address. If either of the values is not IP data, the
+// values are compared with LessData.
+func LessIP(a, b Data) bool {
+	ia, ok := a.(*IP)
+	if !ok {
+		return LessData(a, b)
+	}
+	ib, ok := b.(*IP)
+	if !ok {
+		return LessData(a, b)
+	}
+	cmp := ia.addr.Compare(ib.addr)
+	if cmp != 0 {
+		return cmp < 0
+	}
+	return ia.bits < ib.bits
+}
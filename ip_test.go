@@ -0,0 +1,84 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"encoding/json"
+	"net/netip"
+	"strings"
+	"testing"
+)
+
+func TestIP(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Network").SetLess(LessIP)
+	tab.Header("Name")
+
+	for _, r := range []struct {
+		data Data
+		name string
+	}{
+		{NewPrefix(netip.MustParsePrefix("192.168.1.0/24")), "lan"},
+		{NewIP(netip.MustParseAddr("10.0.0.1")), "gw"},
+		{NewIP(netip.MustParseAddr("9.9.9.9")), "dns"},
+		{NewPrefix(netip.MustParsePrefix("10.0.0.1/8")), "net"},
+	} {
+		row := tab.Row()
+		row.ColumnData(r.data)
+		row.Column(r.name)
+	}
+	tab.Sort(0)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +----------------+------+
+        | Network        | Name |
+        +----------------+------+
+        | 9.9.9.9        | dns  |
+        | 10.0.0.1       | gw   |
+        | 10.0.0.1/8     | net  |
+        | 192.168.1.0/24 | lan  |
+        +----------------+------+
+`
+	match(t, sb.String(), expected, "TestIP")
+
+	data, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	expected = `{"10.0.0.1":"gw","10.0.0.1/8":"net","192.168.1.0/24":"lan","9.9.9.9":"dns"}`
+	if string(data) != expected {
+		t.Errorf("TestIP JSON: got %s, expected %s", data, expected)
+	}
+}
+
+func TestIPPadded(t *testing.T) {
+	tests := []struct {
+		data     *IP
+		expected string
+	}{
+		{
+			data:     NewIP(netip.MustParseAddr("10.0.0.1")),
+			expected: "010.000.000.001",
+		},
+		{
+			data:     NewPrefix(netip.MustParsePrefix("10.0.0.0/8")),
+			expected: "010.000.000.000/08",
+		},
+		{
+			data:     NewIP(netip.MustParseAddr("2001:db8::1")),
+			expected: "2001:0db8:0000:0000:0000:0000:0000:0001",
+		},
+	}
+	for _, test := range tests {
+		str := test.data.SetPadded(true).String()
+		if str != test.expected {
+			t.Errorf("padded: got %s, expected %s", str, test.expected)
+		}
+	}
+}
@@ -7,16 +7,154 @@
 package tabulate
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"io"
+	"net/netip"
+	"regexp"
+	"strconv"
 )
 
+// JSONMode specifies how the table rows are encoded in the JSON
+// output.
+type JSONMode int
+
+// JSON encoding modes. The JSONObject mode encodes the rows as an
+// object keyed by the first column; the object keys are sorted and
+// the later rows overwrite the earlier rows with the same key. The
+// JSONOrdered mode encodes the rows as an object which preserves the
+// row order; the duplicate keys are reported as errors. The JSONPairs
+// mode encodes the rows as an array of [key, value] pairs which
+// preserves the row order and the duplicate keys. The JSONTable mode
+// encodes the table as an object with the header labels in the
+// "headers" array and the rows, including their first columns, as
+// arrays in the "rows" array; the missing cells are encoded as null.
+// The JSONRecords mode encodes the rows as an array of objects which
+// are keyed by the header labels.
+const (
+	JSONObject JSONMode = iota
+	JSONOrdered
+	JSONPairs
+	JSONTable
+	JSONRecords
+)
+
+// SetJSONMode sets the JSON encoding mode of the table rows.
+func (t *Tabulate) SetJSONMode(mode JSONMode) {
+	t.jsonMode = mode
+}
+
+// SetJSONKeyColumn sets the column which is used as the key in the
+// JSONObject, JSONOrdered, and JSONPairs modes. The default key column
+// is the first column.
+func (t *Tabulate) SetJSONKeyColumn(idx int) {
+	t.jsonKey = idx
+}
+
+// SetJSONIndent sets the indentation of the JSON style output. The
+// output is formatted like json.MarshalIndent formats its output with
+// the prefix and indent. The empty prefix and indent print compact
+// JSON.
+func (t *Tabulate) SetJSONIndent(prefix, indent string) {
+	t.jsonPrefix = prefix
+	t.jsonIndent = indent
+}
+
+// SetJSONInferTypes sets if the types of the text cells are inferred
+// in the JSON output. If enabled, the cells containing the text true,
+// false, or null are encoded as the corresponding JSON values and the
+// cells containing JSON numbers are encoded as numbers. The keys are
+// always encoded as strings.
+func (t *Tabulate) SetJSONInferTypes(infer bool) {
+	t.jsonInfer = infer
+}
+
+// inferJSON returns the JSON value of the cell text.
+func inferJSON(s string) interface{} {
+	switch s {
+	case "true":
+		return true
+	case "false":
+		return false
+	case "null":
+		return nil
+	}
+	if jsonNumberRE.MatchString(s) {
+		return json.Number(s)
+	}
+	return s
+}
+
+// jsonNumberRE matches the JSON number grammar without surrounding
+// whitespace.
+var jsonNumberRE = regexp.MustCompile(
+	`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][-+]?[0-9]+)?$`)
+
+// orderedObject implements a JSON object which preserves the order of
+// its keys.
+type orderedObject struct {
+	keys   []string
+	values []interface{}
+}
+
+// MarshalJSON implements the JSON Marshaler interface.
+func (obj *orderedObject) MarshalJSON() ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteByte('{')
+	for idx, key := range obj.keys {
+		if idx > 0 {
+			buf.WriteByte(',')
+		}
+		k, err := json.Marshal(key)
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(k)
+		buf.WriteByte(':')
+		v, err := json.Marshal(obj.values[idx])
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(v)
+	}
+	buf.WriteByte('}')
+	return buf.Bytes(), nil
+}
+
 type jsonMarshaler interface {
 	marshalJSON() (interface{}, error)
 }
 
+// JSONData is implemented by Data types which marshal their values
+// as structured JSON. Without it, the data is marshaled as its String
+// value. The MarshalTableJSON returns a value which is marshaled with
+// encoding/json.
+type JSONData interface {
+	MarshalTableJSON() (interface{}, error)
+}
+
+// marshalData returns the JSON value of the data. The ok is false if
+// the data does not have a structured JSON value.
+func marshalData(data Data) (v interface{}, ok bool, err error) {
+	switch d := data.(type) {
+	case JSONData:
+		v, err = d.MarshalTableJSON()
+		return v, true, err
+	case jsonMarshaler:
+		v, err = d.marshalJSON()
+		return v, true, err
+	default:
+		return nil, false, nil
+	}
+}
+
 // MarshalJSON implements the JSON Marshaler interface.
 func (t *Tabulate) MarshalJSON() ([]byte, error) {
+	if len(t.compactRows) > 0 {
+		return t.derive().MarshalJSON()
+	}
 	content, err := t.marshalJSON()
 	if err != nil {
 		return nil, err
@@ -24,57 +162,348 @@ func (t *Tabulate) MarshalJSON() ([]byte, error) {
 	return json.Marshal(content)
 }
 
-func (t *Tabulate) marshalJSON() (interface{}, error) {
-	content := make(map[string]interface{})
+// outputJSON is the Output function of the JSON style. The rows are
+// encoded directly into the output writer and the errors are reported
+// to the writer's error sink. Without an error sink, as with Print,
+// the error is written to the output. The group header rows are
+// skipped.
+func outputJSON(t *Tabulate, o io.Writer) {
+	if err := t.encodeJSON(o); err != nil && !fail(o, err) {
+		fmt.Fprintf(o, "JSON marshal failed: %s\n", err)
+	}
+}
+
+// encodeJSON encodes the table into the writer. The compact output of
+// all modes except JSONObject is streamed row by row.
+func (t *Tabulate) encodeJSON(w io.Writer) error {
+	indent := len(t.jsonPrefix) > 0 || len(t.jsonIndent) > 0
+	if t.jsonMode == JSONObject || indent {
+		content, err := t.marshalJSON()
+		if err != nil {
+			return err
+		}
+		enc := json.NewEncoder(w)
+		enc.SetIndent(t.jsonPrefix, t.jsonIndent)
+		return enc.Encode(content)
+	}
 
+	start, end := "{", "}"
+	switch t.jsonMode {
+	case JSONPairs:
+		start, end = "[", "]"
+	case JSONTable:
+		headers, err := json.Marshal(t.jsonHeaders())
+		if err != nil {
+			return err
+		}
+		start = `{"headers":` + string(headers) + `,"rows":[`
+		end = "]}"
+	case JSONRecords:
+		start, end = "[", "]"
+	}
+	if _, err := io.WriteString(w, start); err != nil {
+		return err
+	}
+	seen := make(map[string]bool)
+	var count int
 	for _, row := range t.Rows {
-		if len(row.Columns) < 2 {
-			return nil, errors.New("JSON tabulation must have at least two columns")
-		}
-		var columns []interface{}
-		for i := 1; i < len(row.Columns); i++ {
-			col := row.Columns[i]
-			marshaler, ok := col.Data.(jsonMarshaler)
-			if ok {
-				v, err := marshaler.marshalJSON()
-				if err != nil {
-					return nil, err
-				}
-				columns = append(columns, v)
-			} else {
-				columns = append(columns, col.Data.String())
+		if row.group {
+			continue
+		}
+		var element interface{}
+		var key string
+		var value interface{}
+		var err error
+		switch t.jsonMode {
+		case JSONTable:
+			element, err = t.jsonTableRow(row)
+		case JSONRecords:
+			element, err = t.jsonRecord(row)
+		default:
+			key, value, err = t.jsonRow(row)
+		}
+		if err != nil {
+			return err
+		}
+		switch t.jsonMode {
+		case JSONTable, JSONRecords:
+		case JSONPairs:
+			element = []interface{}{key, value}
+		default:
+			if seen[key] {
+				return fmt.Errorf("duplicate JSON key: %s", key)
+			}
+			seen[key] = true
+			element = &orderedObject{
+				keys:   []string{key},
+				values: []interface{}{value},
 			}
 		}
-		key := row.Columns[0].Data.String()
-		if len(columns) > 1 {
-			content[key] = columns
-		} else {
-			content[key] = columns[0]
+		data, err := json.Marshal(element)
+		if err != nil {
+			return err
+		}
+		if t.jsonMode == JSONOrdered {
+			// Strip the braces of the single key object.
+			data = data[1 : len(data)-1]
+		}
+		if count > 0 {
+			if _, err := io.WriteString(w, ","); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
 		}
+		count++
 	}
+	_, err := io.WriteString(w, end+"\n")
+	return err
+}
 
-	return content, nil
+// jsonRow returns the JSON key and value of the row.
+func (t *Tabulate) jsonRow(row *Row) (string, interface{}, error) {
+	if len(row.Columns) < 2 {
+		return "", nil, errors.New("JSON tabulation must have at least two columns")
+	}
+	if t.jsonKey < 0 || t.jsonKey >= len(row.Columns) {
+		return "", nil, fmt.Errorf("JSON key column %d missing", t.jsonKey)
+	}
+	count := len(row.Columns)
+	if len(t.placeholder) > 0 && len(t.Headers) > count {
+		count = len(t.Headers)
+	}
+	var columns []interface{}
+	for i := 0; i < count; i++ {
+		if i == t.jsonKey {
+			continue
+		}
+		col := &Column{}
+		if i < len(row.Columns) {
+			col = row.Columns[i]
+		}
+		v, err := t.jsonCell(t.fill(col))
+		if err != nil {
+			return "", nil, err
+		}
+		columns = append(columns, v)
+	}
+	key := stripANSI(row.Columns[t.jsonKey].Data.String())
+	if len(columns) > 1 {
+		return key, columns, nil
+	}
+	return key, columns[0], nil
+}
+
+// jsonCell returns the JSON value of the column.
+func (t *Tabulate) jsonCell(col *Column) (interface{}, error) {
+	if col.Data == nil {
+		return nil, nil
+	}
+	if v, ok, err := marshalData(col.Data); ok {
+		return v, err
+	}
+	if t.jsonInfer {
+		return inferJSON(stripANSI(col.Data.String())), nil
+	}
+	return stripANSI(col.Data.String()), nil
+}
+
+// jsonHeaders returns the header labels for the JSONTable mode.
+func (t *Tabulate) jsonHeaders() []string {
+	headers := []string{}
+	for _, hdr := range t.Headers {
+		var label string
+		if hdr.Data != nil {
+			label = stripANSI(hdr.Data.String())
+		}
+		headers = append(headers, label)
+	}
+	return headers
+}
+
+// jsonRecord returns the row as an object keyed by the header labels
+// for the JSONRecords mode. The columns without headers are keyed by
+// their column indices.
+func (t *Tabulate) jsonRecord(row *Row) (*orderedObject, error) {
+	values, err := t.jsonTableRow(row)
+	if err != nil {
+		return nil, err
+	}
+	headers := t.jsonHeaders()
+	record := &orderedObject{
+		values: values,
+	}
+	seen := make(map[string]bool)
+	for idx := range values {
+		key := strconv.Itoa(idx)
+		if idx < len(headers) {
+			key = headers[idx]
+		}
+		if seen[key] {
+			return nil, fmt.Errorf("duplicate JSON key: %s", key)
+		}
+		seen[key] = true
+		record.keys = append(record.keys, key)
+	}
+	return record, nil
+}
+
+// jsonTableRow returns the JSON values of the row cells for the
+// JSONTable mode. The row is padded to the number of headers.
+func (t *Tabulate) jsonTableRow(row *Row) ([]interface{}, error) {
+	count := len(row.Columns)
+	if len(t.Headers) > count {
+		count = len(t.Headers)
+	}
+	values := []interface{}{}
+	for i := 0; i < count; i++ {
+		col := emptyColumn
+		if i < len(row.Columns) {
+			col = row.Columns[i]
+		}
+		v, err := t.jsonCell(t.fill(col))
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, v)
+	}
+	return values, nil
+}
+
+func (t *Tabulate) marshalJSON() (interface{}, error) {
+	if t.jsonMode == JSONRecords {
+		records := []interface{}{}
+		for _, row := range t.Rows {
+			if row.group {
+				continue
+			}
+			record, err := t.jsonRecord(row)
+			if err != nil {
+				return nil, err
+			}
+			records = append(records, record)
+		}
+		return records, nil
+	}
+	if t.jsonMode == JSONTable {
+		var rows [][]interface{}
+		for _, row := range t.Rows {
+			if row.group {
+				continue
+			}
+			values, err := t.jsonTableRow(row)
+			if err != nil {
+				return nil, err
+			}
+			rows = append(rows, values)
+		}
+		if rows == nil {
+			rows = [][]interface{}{}
+		}
+		return &orderedObject{
+			keys:   []string{"headers", "rows"},
+			values: []interface{}{t.jsonHeaders(), rows},
+		}, nil
+	}
+
+	var keys []string
+	var values []interface{}
+
+	for _, row := range t.Rows {
+		if row.group {
+			continue
+		}
+		key, value, err := t.jsonRow(row)
+		if err != nil {
+			return nil, err
+		}
+		keys = append(keys, key)
+		values = append(values, value)
+	}
+
+	switch t.jsonMode {
+	case JSONOrdered:
+		seen := make(map[string]bool)
+		for _, key := range keys {
+			if seen[key] {
+				return nil, fmt.Errorf("duplicate JSON key: %s", key)
+			}
+			seen[key] = true
+		}
+		return &orderedObject{
+			keys:   keys,
+			values: values,
+		}, nil
+
+	case JSONPairs:
+		pairs := []interface{}{}
+		for idx, key := range keys {
+			pairs = append(pairs, []interface{}{key, values[idx]})
+		}
+		return pairs, nil
+
+	default:
+		content := make(map[string]interface{})
+		for idx, key := range keys {
+			content[key] = values[idx]
+		}
+		return content, nil
+	}
 }
 
 func (v *Value) marshalJSON() (interface{}, error) {
 	return v.value, nil
 }
 
+func (n *Null) marshalJSON() (interface{}, error) {
+	return nil, nil
+}
+
 func (arr *Slice) marshalJSON() (interface{}, error) {
 	var content []interface{}
 
 	for _, data := range arr.content {
-		marshaler, ok := data.(jsonMarshaler)
+		v, ok, err := marshalData(data)
+		if err != nil {
+			return nil, err
+		}
 		if ok {
-			v, err := marshaler.marshalJSON()
-			if err != nil {
-				return nil, err
-			}
 			content = append(content, v)
 		} else {
-			content = append(content, data.String())
+			content = append(content, stripANSI(data.String()))
 		}
 	}
 
 	return content, nil
 }
+
+func (e *Error) marshalJSON() (interface{}, error) {
+	return map[string]interface{}{
+		"error": e.String(),
+	}, nil
+}
+
+func (ip *IP) marshalJSON() (interface{}, error) {
+	if ip.bits < 0 {
+		return ip.addr.String(), nil
+	}
+	return netip.PrefixFrom(ip.addr, ip.bits).String(), nil
+}
+
+func (tree *Tree) marshalJSON() (interface{}, error) {
+	if len(tree.Children) == 0 {
+		return tree.Label, nil
+	}
+	var children []interface{}
+	for _, child := range tree.Children {
+		v, err := child.marshalJSON()
+		if err != nil {
+			return nil, err
+		}
+		children = append(children, v)
+	}
+	return map[string]interface{}{
+		tree.Label: children,
+	}, nil
+}
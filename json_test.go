@@ -8,7 +8,9 @@ package tabulate
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 )
 
@@ -136,3 +138,293 @@ func TestJSONCertReflect(t *testing.T) {
 		fmt.Printf("JSON marshal cert reflect:\n%s\n", string(data))
 	}
 }
+
+func TestJSONError(t *testing.T) {
+	tab := New(JSON)
+	row := tab.Row()
+	row.Column("b")
+	row.ColumnData(NewError(errors.New("connection refused")))
+
+	data, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	expected := `{"b":{"error":"connection refused"}}`
+	if string(data) != expected {
+		t.Errorf("TestJSONError: got %s, expected %s", data, expected)
+	}
+}
+
+func TestJSONFloat(t *testing.T) {
+	tab := New(JSON)
+	row := tab.Row()
+	row.Column("pi")
+	row.ColumnData(NewFloat(3.14159, 2))
+
+	if s := row.Columns[1].Data.String(); s != "3.14" {
+		t.Errorf("TestJSONFloat: got %s, expected 3.14", s)
+	}
+
+	data, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	expected := `{"pi":3.14159}`
+	if string(data) != expected {
+		t.Errorf("TestJSONFloat: got %s, expected %s", data, expected)
+	}
+}
+
+func TestJSONModes(t *testing.T) {
+	tab := New(JSON)
+	tab.SetHeaders("Key", "Value")
+	tab.AddRow("b", 1)
+	tab.AddRow("a", 2)
+	tab.AddRow("b", 3)
+
+	tests := []struct {
+		mode     JSONMode
+		expected string
+	}{
+		{JSONObject, `{"a":2,"b":3}`},
+		{JSONPairs, `[["b",1],["a",2],["b",3]]`},
+	}
+	for _, test := range tests {
+		tab.SetJSONMode(test.mode)
+		data, err := json.Marshal(tab)
+		if err != nil {
+			t.Fatalf("JSON marshal failed: %s", err)
+		}
+		if string(data) != test.expected {
+			t.Errorf("JSON mode %d: got %s, expected %s",
+				test.mode, data, test.expected)
+		}
+	}
+
+	tab.SetJSONMode(JSONOrdered)
+	if _, err := json.Marshal(tab); err == nil {
+		t.Errorf("JSONOrdered accepted duplicate keys")
+	}
+	tab.RemoveRow(2)
+	data, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	if string(data) != `{"b":1,"a":2}` {
+		t.Errorf("JSONOrdered: got %s", data)
+	}
+}
+
+func TestJSONOutput(t *testing.T) {
+	tab := New(JSON)
+	tab.SetHeaders("Key", "Value")
+	tab.AddRow("rate", "100%")
+	tab.AddRow("other", 2)
+
+	for _, test := range []struct {
+		mode     JSONMode
+		expected string
+	}{
+		{JSONObject, `{"other":2,"rate":"100%"}` + "\n"},
+		{JSONOrdered, `{"rate":"100%","other":2}` + "\n"},
+		{JSONPairs, `[["rate","100%"],["other",2]]` + "\n"},
+	} {
+		tab.SetJSONMode(test.mode)
+		var sb strings.Builder
+		tab.Print(&sb)
+		if sb.String() != test.expected {
+			t.Errorf("JSON output mode %d: got %q, expected %q",
+				test.mode, sb.String(), test.expected)
+		}
+	}
+
+	tab.AddRow("rate", 3)
+	tab.SetJSONMode(JSONOrdered)
+	var sb strings.Builder
+	if _, err := tab.Fprint(&sb); err == nil {
+		t.Errorf("Fprint did not return duplicate key error")
+	}
+	if strings.Contains(sb.String(), "JSON marshal failed") {
+		t.Errorf("Fprint wrote the error to the output: %q", sb.String())
+	}
+
+	sb.Reset()
+	tab.Print(&sb)
+	if !strings.Contains(sb.String(), "JSON marshal failed: ") {
+		t.Errorf("Print did not report duplicate key error: %q", sb.String())
+	}
+}
+
+func TestJSONInferTypes(t *testing.T) {
+	rows := `Year,Income,Ratio,Audited,Note,Count
+2018,100,0.5,true,null,1
+2019,-110,1e3,false,-,100 
+2020,0x10,1.50, 7,text,01`
+
+	tab := tabulate(New(JSON), TL, rows)
+	tab.SetJSONInferTypes(true)
+	data, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	expected := `{"2018":[100,0.5,true,null,1],` +
+		`"2019":[-110,1e3,false,"-","100 "],` +
+		`"2020":["0x10",1.50," 7","text","01"]}`
+	if string(data) != expected {
+		t.Errorf("TestJSONInferTypes: got %s, expected %s", data, expected)
+	}
+}
+
+func TestJSONTable(t *testing.T) {
+	tab := New(JSON)
+	tab.SetHeaders("Name", "Count", "Note")
+	tab.AddRow("alpha", 1, "first")
+	tab.AddRow("beta", 2)
+	tab.SetJSONMode(JSONTable)
+
+	expected := `{"headers":["Name","Count","Note"],` +
+		`"rows":[["alpha",1,"first"],["beta",2,null]]}`
+
+	data, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	if string(data) != expected {
+		t.Errorf("TestJSONTable: got %s, expected %s", data, expected)
+	}
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	if sb.String() != expected+"\n" {
+		t.Errorf("TestJSONTable: got %s, expected %s", sb.String(), expected)
+	}
+
+	empty := New(JSON)
+	empty.Header("Name")
+	empty.SetJSONMode(JSONTable)
+	data, err = json.Marshal(empty)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	if string(data) != `{"headers":["Name"],"rows":[]}` {
+		t.Errorf("TestJSONTable: got %s", data)
+	}
+}
+
+func TestJSONKeyColumn(t *testing.T) {
+	tab := New(JSON)
+	tab.SetHeaders("Count", "Name", "Note")
+	tab.AddRow(1, "alpha", "first")
+	tab.AddRow(2, "beta", "second")
+	tab.SetJSONKeyColumn(1)
+
+	data, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	expected := `{"alpha":[1,"first"],"beta":[2,"second"]}`
+	if string(data) != expected {
+		t.Errorf("TestJSONKeyColumn: got %s, expected %s", data, expected)
+	}
+
+	tab.SetJSONKeyColumn(5)
+	if _, err := json.Marshal(tab); err == nil {
+		t.Errorf("TestJSONKeyColumn: missing key column accepted")
+	}
+}
+
+func TestJSONRecords(t *testing.T) {
+	tab := New(JSON)
+	tab.SetHeaders("Name", "Count")
+	tab.AddRow("alpha", 1)
+	tab.AddRow("beta", 2, "extra")
+	tab.SetJSONMode(JSONRecords)
+
+	expected := `[{"Name":"alpha","Count":1},` +
+		`{"Name":"beta","Count":2,"2":"extra"}]`
+	data, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	if string(data) != expected {
+		t.Errorf("TestJSONRecords: got %s, expected %s", data, expected)
+	}
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	if sb.String() != expected+"\n" {
+		t.Errorf("TestJSONRecords: got %s, expected %s", sb.String(), expected)
+	}
+}
+
+type point struct {
+	x, y int
+}
+
+func (p *point) Width(m Measure) int {
+	return m(p.String())
+}
+
+func (p *point) Height() int {
+	return 1
+}
+
+func (p *point) Content(row int) string {
+	if row > 0 {
+		return ""
+	}
+	return p.String()
+}
+
+func (p *point) String() string {
+	return fmt.Sprintf("(%d,%d)", p.x, p.y)
+}
+
+func (p *point) MarshalTableJSON() (interface{}, error) {
+	return map[string]int{"x": p.x, "y": p.y}, nil
+}
+
+func TestJSONData(t *testing.T) {
+	tab := New(JSON)
+	tab.SetHeaders("Name", "Point", "Path")
+	arr := NewSlice(80)
+	arr.Append(&point{x: 0, y: 0})
+	arr.Append(&point{x: 1, y: 2})
+	tab.AddRow("origin", &point{x: 0, y: 0}, arr)
+
+	data, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	expected := `{"origin":[{"x":0,"y":0},[{"x":0,"y":0},{"x":1,"y":2}]]}`
+	if string(data) != expected {
+		t.Errorf("TestJSONData: got %s, expected %s", data, expected)
+	}
+}
+
+func TestJSONIndent(t *testing.T) {
+	tab := New(JSON)
+	tab.SetHeaders("Name", "Count")
+	tab.AddRow("beta", 2)
+	tab.AddRow("alpha", 1)
+	tab.SetJSONIndent("", "  ")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        {
+          "alpha": 1,
+          "beta": 2
+        }
+`, "TestJSONIndent")
+
+	sb.Reset()
+	tab.SetJSONMode(JSONOrdered)
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        {
+          "beta": 2,
+          "alpha": 1
+        }
+`, "TestJSONIndent ordered")
+}
@@ -0,0 +1,121 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+// LiveTable keeps a table rendered at the bottom of a terminal. The
+// table cells are updated with Update and only the changed lines are
+// repainted with ANSI cursor movement. The LiveTable is safe for
+// concurrent use but the table must not be modified directly while it
+// is being updated.
+type LiveTable struct {
+	t     *Tabulate
+	w     io.Writer
+	lines []string
+	mu    sync.Mutex
+}
+
+// NewLiveTable creates a live table which renders the table into the
+// writer. The table is printed with the first Repaint or Update.
+func NewLiveTable(t *Tabulate, w io.Writer) *LiveTable {
+	return &LiveTable{
+		t: t,
+		w: w,
+	}
+}
+
+// Table returns the live table's tabulator.
+func (l *LiveTable) Table() *Tabulate {
+	return l.t
+}
+
+// Update sets the data of the column col of the data row row and
+// repaints the changed lines. If the table does not have enough rows,
+// the function adds empty rows until the row row exists. The function
+// returns the first rendering or write error.
+func (l *LiveTable) Update(row, col int, data Data) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if row < 0 || col < 0 {
+		return fmt.Errorf("invalid cell %d,%d", row, col)
+	}
+	for len(l.t.Rows) <= row {
+		l.t.Row()
+	}
+	if l.t.Rows[row].Set(col, data) == nil {
+		return fmt.Errorf("cell %d,%d is covered by a spanning column",
+			row, col)
+	}
+	return l.repaint()
+}
+
+// Repaint repaints the lines which have changed since the previous
+// rendering. It must be called after the table is modified directly.
+func (l *LiveTable) Repaint() error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.repaint()
+}
+
+func (l *LiveTable) repaint() error {
+	var render strings.Builder
+	if _, err := l.t.Fprint(&render); err != nil {
+		return err
+	}
+	lines := strings.Split(strings.TrimSuffix(render.String(), "\n"), "\n")
+	if render.Len() == 0 {
+		lines = nil
+	}
+
+	var sb strings.Builder
+	if len(lines) != len(l.lines) {
+		// The table height changed: redraw all lines.
+		if len(l.lines) > 0 {
+			fmt.Fprintf(&sb, "\x1b[%dA", len(l.lines))
+		}
+		sb.WriteString("\r\x1b[J")
+		for _, line := range lines {
+			sb.WriteString(line)
+			sb.WriteByte('\n')
+		}
+	} else {
+		// The cursor is below the last line of the table.
+		cursor := len(lines)
+		for idx, line := range lines {
+			if line == l.lines[idx] {
+				continue
+			}
+			// Move the cursor from its current row to the line.
+			if cursor > idx {
+				fmt.Fprintf(&sb, "\x1b[%dA", cursor-idx)
+			} else if cursor < idx {
+				fmt.Fprintf(&sb, "\x1b[%dB", idx-cursor)
+			}
+			sb.WriteString("\r\x1b[2K")
+			sb.WriteString(line)
+			sb.WriteByte('\n')
+			cursor = idx + 1
+		}
+		if cursor < len(lines) {
+			fmt.Fprintf(&sb, "\x1b[%dB", len(lines)-cursor)
+		}
+	}
+	l.lines = lines
+	if sb.Len() == 0 {
+		return nil
+	}
+	_, err := io.WriteString(l.w, sb.String())
+	return err
+}
@@ -0,0 +1,100 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestLiveTable(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Job")
+	tab.Header("State")
+	tab.AddRow("a", "run")
+	tab.AddRow("b", "run")
+
+	var sb strings.Builder
+	live := NewLiveTable(tab, &sb)
+	if err := live.Repaint(); err != nil {
+		t.Fatalf("Repaint failed: %v", err)
+	}
+	expected := "\r\x1b[J Job  State \n a    run   \n b    run   \n"
+	if sb.String() != expected {
+		t.Errorf("TestLiveTable: got %q, expected %q", sb.String(), expected)
+	}
+
+	sb.Reset()
+	if err := live.Update(0, 1, NewText("ok")); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	expected = "\x1b[2A\r\x1b[2K a    ok    \n\x1b[1B"
+	if sb.String() != expected {
+		t.Errorf("TestLiveTable: got %q, expected %q", sb.String(), expected)
+	}
+
+	sb.Reset()
+	if err := live.Repaint(); err != nil {
+		t.Fatalf("Repaint failed: %v", err)
+	}
+	if sb.Len() != 0 {
+		t.Errorf("TestLiveTable: unchanged repaint wrote %q", sb.String())
+	}
+
+	sb.Reset()
+	if err := live.Update(2, 0, NewText("c")); err != nil {
+		t.Fatalf("Update failed: %v", err)
+	}
+	expected = "\x1b[3A\r\x1b[J Job  State \n a    ok    \n b    run   \n c          \n"
+	if sb.String() != expected {
+		t.Errorf("TestLiveTable: got %q, expected %q", sb.String(), expected)
+	}
+}
+
+func TestLiveTableLines(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Job")
+	tab.Header("State")
+	tab.AddRow("a", "run")
+	tab.AddRow("b", "run")
+	tab.AddRow("c", "run")
+
+	var sb strings.Builder
+	live := NewLiveTable(tab, &sb)
+	if err := live.Repaint(); err != nil {
+		t.Fatalf("Repaint failed: %v", err)
+	}
+
+	// Non-adjacent lines.
+	sb.Reset()
+	tab.Rows[0].Set(1, NewText("ok"))
+	tab.Rows[2].Set(1, NewText("ok"))
+	if err := live.Repaint(); err != nil {
+		t.Fatalf("Repaint failed: %v", err)
+	}
+	expected := "\x1b[3A\r\x1b[2K a    ok    \n" +
+		"\x1b[1B\r\x1b[2K c    ok    \n"
+	if sb.String() != expected {
+		t.Errorf("TestLiveTableLines: got %q, expected %q",
+			sb.String(), expected)
+	}
+
+	// The column width changes all lines.
+	sb.Reset()
+	tab.Rows[1].Set(1, NewText("failed"))
+	if err := live.Repaint(); err != nil {
+		t.Fatalf("Repaint failed: %v", err)
+	}
+	expected = "\x1b[4A\r\x1b[2K Job  State  \n" +
+		"\r\x1b[2K a    ok     \n" +
+		"\r\x1b[2K b    failed \n" +
+		"\r\x1b[2K c    ok     \n"
+	if sb.String() != expected {
+		t.Errorf("TestLiveTableLines: got %q, expected %q",
+			sb.String(), expected)
+	}
+}
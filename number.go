@@ -0,0 +1,118 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+	"golang.org/x/text/number"
+)
+
+// NewNumber creates a new Value for the number. The number is
+// formatted with the thousands separators and decimal mark of the
+// locale, for example "1,234,567.89" in English and "1.234.567,89" in
+// German. The prec specifies the number of digits after the decimal
+// mark; if prec is negative, the number is formatted with as many
+// digits as needed. The JSON marshaling uses the exact value.
+func NewNumber(v float64, prec int, locale language.Tag) *Value {
+	var opts []number.Option
+	if prec >= 0 {
+		opts = append(opts, number.MinFractionDigits(prec),
+			number.MaxFractionDigits(prec))
+	}
+	p := message.NewPrinter(locale)
+	return &Value{
+		string: p.Sprint(number.Decimal(v, opts...)),
+		value:  v,
+	}
+}
+
+// formatNumber formats the numeric Value data with the printf format
+// string f. The integer values are converted to floating point
+// numbers for the floating point verbs and the integral floating
+// point numbers are converted to integers for the integer verbs. The
+// integer values are formatted with their own type so the unsigned
+// values keep their full range. The function returns other data and
+// the values which can not be converted for the verb as-is.
+func formatNumber(f string, data Data) Data {
+	v, ok := data.(*Value)
+	if !ok || len(f) == 0 {
+		return data
+	}
+	var fl float64
+	var isInt bool
+	switch n := v.value.(type) {
+	case int:
+		fl, isInt = float64(n), true
+	case int8:
+		fl, isInt = float64(n), true
+	case int16:
+		fl, isInt = float64(n), true
+	case int32:
+		fl, isInt = float64(n), true
+	case int64:
+		fl, isInt = float64(n), true
+	case uint:
+		fl, isInt = float64(n), true
+	case uint8:
+		fl, isInt = float64(n), true
+	case uint16:
+		fl, isInt = float64(n), true
+	case uint32:
+		fl, isInt = float64(n), true
+	case uint64:
+		fl, isInt = float64(n), true
+	case float32:
+		fl = float64(n)
+	case float64:
+		fl = n
+	default:
+		return data
+	}
+	var arg interface{}
+	switch numberVerb(f) {
+	case 'e', 'E', 'f', 'F', 'g', 'G':
+		arg = fl
+	case 'd', 'x', 'X', 'o', 'O', 'b':
+		if isInt {
+			arg = v.value
+		} else if fl == float64(int64(fl)) {
+			arg = int64(fl)
+		} else {
+			return data
+		}
+	default:
+		return data
+	}
+	return &Value{
+		string: fmt.Sprintf(f, arg),
+		value:  v.value,
+	}
+}
+
+// numberVerb returns the verb of the first formatting directive of
+// the printf format string f. The function returns 0 if the format
+// does not have formatting directives.
+func numberVerb(f string) byte {
+	for i := 0; i < len(f); i++ {
+		if f[i] != '%' {
+			continue
+		}
+		for i++; i < len(f); i++ {
+			c := f[i]
+			if c == '%' {
+				break
+			}
+			if (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') {
+				return c
+			}
+		}
+	}
+	return 0
+}
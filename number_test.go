@@ -0,0 +1,72 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"testing"
+
+	"golang.org/x/text/language"
+)
+
+func TestNumber(t *testing.T) {
+	tests := []struct {
+		v        float64
+		prec     int
+		locale   language.Tag
+		expected string
+	}{
+		{1234567.891, 2, language.English, "1,234,567.89"},
+		{1234567.891, 2, language.German, "1.234.567,89"},
+		{1234567, 0, language.English, "1,234,567"},
+		{0.5, -1, language.German, "0,5"},
+		{12, 2, language.English, "12.00"},
+	}
+	for _, test := range tests {
+		n := NewNumber(test.v, test.prec, test.locale)
+		if n.String() != test.expected {
+			t.Errorf("NewNumber(%v, %d, %s) = %q, expected %q",
+				test.v, test.prec, test.locale, n.String(), test.expected)
+		}
+		if n.value != test.v {
+			t.Errorf("NewNumber(%v): value %v", test.v, n.value)
+		}
+	}
+}
+
+func TestNumberFormat(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Name")
+	tab.Header("Value").SetNumberFormat("%.2f").SetAlign(MR)
+	tab.Header("Hex").SetNumberFormat("0x%04x")
+	tab.AddRow("a", 3, 255)
+	tab.AddRow("b", 1.005, 2.5)
+	tab.AddRow("c", "text", true)
+	tab.Rows[0].Set(1, NewValue(float32(0.5)))
+
+	match(t, tab.Render(Plain), `
+         Name  Value  Hex
+         a      0.50  0x00ff
+         b      1.00  2.5
+         c      text  true
+`, "TestNumberFormat")
+
+	match(t, tab.Render(JSON), `
+        {"a":[0.5,255],"b":[1.005,2.5],"c":["text",true]}
+`, "TestNumberFormat JSON")
+}
+
+func TestNumberFormatUnsigned(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Dec").SetNumberFormat("%d")
+	tab.Header("Hex").SetNumberFormat("%x")
+	tab.AddRow(uint64(1<<63), uint64(1<<64-1))
+
+	match(t, tab.Render(Plain), `
+         Dec                  Hex
+         9223372036854775808  ffffffffffffffff
+`, "TestNumberFormatUnsigned")
+}
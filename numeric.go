@@ -0,0 +1,114 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// SetAutoAlign enables or disables the automatic numeric column
+// alignment. If enabled, the columns whose body cells are all numeric
+// are right aligned unless their alignment is explicitly set with
+// SetAlign.
+func (t *Tabulate) SetAutoAlign(enabled bool) {
+	t.autoAlign = enabled
+}
+
+// isNumeric tests if the value is a number.
+func isNumeric(val string) bool {
+	_, err := strconv.ParseFloat(strings.TrimSpace(stripANSI(val)), 64)
+	return err == nil
+}
+
+// thousandsRE matches numbers with comma thousands separators.
+var thousandsRE = regexp.MustCompile(`^[-+]?[0-9]{1,3}(,[0-9]{3})+(\.[0-9]*)?$`)
+
+// parseNumber parses the finite number from the cell content. The
+// number can contain comma thousands separators.
+func parseNumber(val string) (float64, bool) {
+	val = strings.TrimSpace(stripANSI(val))
+	if strings.IndexByte(val, ',') >= 0 {
+		if !thousandsRE.MatchString(val) {
+			return 0, false
+		}
+		val = strings.ReplaceAll(val, ",", "")
+	}
+	v, err := strconv.ParseFloat(val, 64)
+	if err != nil || math.IsInf(v, 0) || math.IsNaN(v) {
+		return 0, false
+	}
+	return v, true
+}
+
+// numericColumns returns the columns whose body cells are all
+// numeric. The function returns nil if the automatic alignment is
+// disabled.
+func (t *Tabulate) numericColumns() []bool {
+	if !t.autoAlign {
+		return nil
+	}
+	var numeric []bool
+	var nonNumeric []bool
+	t.eachRow(func(row *Row) {
+		var idx int
+		for _, col := range row.Columns {
+			span := col.span()
+			for idx+span > len(numeric) {
+				numeric = append(numeric, false)
+				nonNumeric = append(nonNumeric, false)
+			}
+			if span == 1 {
+				for line := 0; line < col.Height(); line++ {
+					content := col.Content(line)
+					if len(strings.TrimSpace(content)) == 0 {
+						continue
+					}
+					if isNumeric(content) {
+						numeric[idx] = true
+					} else {
+						nonNumeric[idx] = true
+					}
+				}
+			}
+			idx += span
+		}
+	})
+	for idx := range numeric {
+		numeric[idx] = numeric[idx] && !nonNumeric[idx]
+	}
+	return numeric
+}
+
+// alignAt returns the effective alignment of the column at the table
+// column idx.
+func (t *Tabulate) alignAt(col *Column, idx int) Align {
+	if t.mirrored {
+		return mirrorAlign(t.naturalAlignAt(col, idx))
+	}
+	return t.naturalAlignAt(col, idx)
+}
+
+// naturalAlignAt returns the alignment of the column at the table
+// column idx in the left-to-right layout.
+func (t *Tabulate) naturalAlignAt(col *Column, idx int) Align {
+	if col.alignSet || idx >= len(t.numeric) || !t.numeric[idx] {
+		return col.Align
+	}
+	switch col.Align {
+	case TL, TC:
+		return TR
+	case ML, MC:
+		return MR
+	case BL, BC:
+		return BR
+	default:
+		return col.Align
+	}
+}
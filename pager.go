@@ -0,0 +1,79 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// PrintPaged prints the table to the standard output. If the standard
+// output is a terminal and the table is taller than the terminal, the
+// table is piped through the pager specified by the PAGER environment
+// variable, or through "less -R" if the variable is not set. The
+// colors are preserved as specified by the table's color mode. If the
+// pager can not be started, the table is printed directly to the
+// standard output.
+func PrintPaged(tab *Tabulate) error {
+	return printPaged(tab, os.Stdout)
+}
+
+func printPaged(tab *Tabulate, out *os.File) error {
+	if !isTerminal(out) {
+		_, err := tab.Fprint(out)
+		return err
+	}
+
+	// Render the table as it would be rendered to the terminal.
+	view := tab.printView()
+	if tab.stripColors(out) {
+		view.colorMode = ColorNever
+	} else {
+		view.colorMode = ColorAlways
+	}
+	var sb strings.Builder
+	_, err := view.Fprint(&sb)
+	if err != nil {
+		return err
+	}
+	content := sb.String()
+
+	if strings.Count(content, "\n") < terminalHeight(out) {
+		_, err = out.WriteString(content)
+		return err
+	}
+
+	args := strings.Fields(os.Getenv("PAGER"))
+	if len(args) == 0 {
+		args = []string{"less", "-R"}
+	}
+	cmd := exec.Command(args[0], args[1:]...)
+	cmd.Stdin = strings.NewReader(content)
+	cmd.Stdout = out
+	cmd.Stderr = os.Stderr
+	if err := cmd.Start(); err != nil {
+		_, err = out.WriteString(content)
+		return err
+	}
+	return cmd.Wait()
+}
+
+// terminalHeight returns the height of the terminal in lines. If the
+// height can not be queried from the terminal, the function uses the
+// LINES environment variable, and defaults to 24 lines.
+func terminalHeight(f *os.File) int {
+	if height, ok := terminalSize(f); ok && height > 0 {
+		return height
+	}
+	if height, err := strconv.Atoi(os.Getenv("LINES")); err == nil &&
+		height > 0 {
+		return height
+	}
+	return 24
+}
@@ -0,0 +1,53 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPrintPagedFile(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Name")
+	tab.AddRow("alpha")
+
+	f, err := os.Create(filepath.Join(t.TempDir(), "out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	if err := printPaged(tab, f); err != nil {
+		t.Fatalf("printPaged failed: %v", err)
+	}
+	data, err := os.ReadFile(f.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(data) != tab.Render(Plain) {
+		t.Errorf("printPaged: got %q, expected %q", data, tab.Render(Plain))
+	}
+}
+
+func TestTerminalHeight(t *testing.T) {
+	f, err := os.Create(filepath.Join(t.TempDir(), "out"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	t.Setenv("LINES", "42")
+	if h := terminalHeight(f); h != 42 {
+		t.Errorf("terminalHeight: got %d, expected 42", h)
+	}
+	t.Setenv("LINES", "")
+	if h := terminalHeight(f); h != 24 {
+		t.Errorf("terminalHeight: got %d, expected 24", h)
+	}
+}
@@ -0,0 +1,77 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"sync"
+)
+
+// parallelMinRows specifies the minimum number of data rows for the
+// parallel column measurement.
+const parallelMinRows = 1024
+
+// SetParallel sets the number of goroutines used for measuring the
+// column widths of large tables. The value 0 or 1 measures the columns
+// sequentially. The parallel measurement calls the cell Data's Width
+// methods concurrently so the table Measure function must be safe for
+// concurrent use.
+func (t *Tabulate) SetParallel(workers int) {
+	t.parallel = workers
+}
+
+// measureParallel measures the data rows in chunks with t.parallel
+// goroutines and merges the chunk widths to the argument widths.
+func (t *Tabulate) measureParallel(widths []int) []int {
+	numRows := t.NumRows()
+	workers := t.parallel
+	if workers > numRows {
+		workers = numRows
+	}
+	chunk := (numRows + workers - 1) / workers
+
+	results := make([][]int, workers)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		start := w * chunk
+		end := start + chunk
+		if end > numRows {
+			end = numRows
+		}
+		wg.Add(1)
+		go func(w, start, end int) {
+			defer wg.Done()
+			result := make([]int, len(widths))
+			copy(result, widths)
+			for i := start; i < end; i++ {
+				result = t.measureRow(t.rowAt(i), result)
+			}
+			results[w] = result
+		}(w, start, end)
+	}
+	wg.Wait()
+
+	for _, result := range results {
+		for len(widths) < len(result) {
+			widths = append(widths, 0)
+		}
+		for idx, w := range result {
+			if w > widths[idx] {
+				widths[idx] = w
+			}
+		}
+	}
+	return widths
+}
+
+// rowAt returns the data row i. The compact rows are converted to
+// transient rows.
+func (t *Tabulate) rowAt(i int) *Row {
+	if i < len(t.Rows) {
+		return t.Rows[i]
+	}
+	return t.compactRow(t.compactRows[i-len(t.Rows)])
+}
@@ -0,0 +1,79 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+)
+
+func TestParallel(t *testing.T) {
+	tabs := []*Tabulate{New(Unicode), New(Unicode)}
+	for _, tab := range tabs {
+		tab.SetHeaders("Index", "Name", "Value")
+		tab.SetCompact(true)
+		for i := 0; i < 3*parallelMinRows; i++ {
+			name := strings.Repeat("x", i%37)
+			if i < parallelMinRows {
+				tab.AddRow(i, name)
+			} else {
+				tab.AddStrings(fmt.Sprint(i), name, fmt.Sprint(i*i))
+			}
+		}
+	}
+	tabs[1].SetParallel(4)
+
+	var expected, result strings.Builder
+	tabs[0].Print(&expected)
+	tabs[1].Print(&result)
+	if result.String() != expected.String() {
+		t.Errorf("TestParallel: parallel and sequential output differ")
+	}
+	widths := tabs[1].measure(tabs[1].Headers)
+	if fmt.Sprint(widths) != "[5 36 7]" {
+		t.Errorf("TestParallel: widths %v", widths)
+	}
+}
+
+func TestParallelSharedData(t *testing.T) {
+	shared := []Data{
+		NewText("shared"),
+		NewLazy(func() string { return "lazy" }),
+		NewTree("root"),
+	}
+	arr := NewSlice(80)
+	arr.Append(NewText("a"))
+	arr.Append(NewText("b"))
+	shared = append(shared, arr)
+
+	tabs := []*Tabulate{New(Plain), New(Plain)}
+	for _, tab := range tabs {
+		tab.SetParallel(4)
+		tab.Header("Value")
+		for i := 0; i < 2*parallelMinRows; i++ {
+			tab.Row().ColumnData(shared[i%len(shared)])
+		}
+	}
+
+	var wg sync.WaitGroup
+	results := make([]string, len(tabs))
+	for idx, tab := range tabs {
+		wg.Add(1)
+		go func(idx int, tab *Tabulate) {
+			defer wg.Done()
+			var sb strings.Builder
+			tab.Print(&sb)
+			results[idx] = sb.String()
+		}(idx, tab)
+	}
+	wg.Wait()
+	if results[0] != results[1] {
+		t.Errorf("TestParallelSharedData: outputs differ")
+	}
+}
@@ -0,0 +1,127 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Profile specifies the table appearance. Profiles are typically
+// loaded from configuration files with LoadProfile so that the users
+// can customize the tables without code changes.
+type Profile struct {
+	Style    string          `json:"style,omitempty"`
+	Padding  *int            `json:"padding,omitempty"`
+	MaxWidth int             `json:"maxWidth,omitempty"`
+	Columns  []ColumnProfile `json:"columns,omitempty"`
+}
+
+// ColumnProfile specifies the column appearance. The column is
+// selected by its header label Name or by its Index.
+type ColumnProfile struct {
+	Name     string `json:"name,omitempty"`
+	Index    *int   `json:"index,omitempty"`
+	Align    string `json:"align,omitempty"`
+	Format   string `json:"format,omitempty"`
+	Width    int    `json:"width,omitempty"`
+	MinWidth int    `json:"minWidth,omitempty"`
+	Hidden   bool   `json:"hidden,omitempty"`
+}
+
+var formats = map[string]Format{
+	"none":   FmtNone,
+	"bold":   FmtBold,
+	"italic": FmtItalic,
+	"red":    FmtRed,
+	"green":  FmtGreen,
+	"yellow": FmtYellow,
+}
+
+// LoadProfile loads a JSON encoded profile from the reader.
+func LoadProfile(r io.Reader) (*Profile, error) {
+	profile := new(Profile)
+	decoder := json.NewDecoder(r)
+	decoder.DisallowUnknownFields()
+	if err := decoder.Decode(profile); err != nil {
+		return nil, err
+	}
+	return profile, nil
+}
+
+// Apply applies the profile to the tabulator. The column profiles are
+// applied to the tabulator's header columns so the headers must be
+// defined before the profile is applied.
+func (p *Profile) Apply(t *Tabulate) error {
+	if len(p.Style) > 0 {
+		style, err := ParseStyle(p.Style)
+		if err != nil {
+			return err
+		}
+		t.SetStyle(style)
+	}
+	if p.Padding != nil {
+		t.Padding = *p.Padding
+	}
+	if p.MaxWidth > 0 {
+		t.MaxWidth = p.MaxWidth
+	}
+	for _, cp := range p.Columns {
+		idx, err := cp.column(t)
+		if err != nil {
+			return err
+		}
+		if err := cp.apply(t, idx); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (cp *ColumnProfile) column(t *Tabulate) (int, error) {
+	if cp.Index != nil {
+		if *cp.Index < 0 || *cp.Index >= len(t.Headers) {
+			return 0, fmt.Errorf("invalid column index: %d", *cp.Index)
+		}
+		return *cp.Index, nil
+	}
+	for idx, hdr := range t.Headers {
+		if hdr.Data != nil && hdr.Data.String() == cp.Name {
+			return idx, nil
+		}
+	}
+	return 0, fmt.Errorf("unknown column: %s", cp.Name)
+}
+
+func (cp *ColumnProfile) apply(t *Tabulate, idx int) error {
+	hdr := t.Headers[idx]
+	if len(cp.Align) > 0 {
+		align, err := ParseAlign(cp.Align)
+		if err != nil {
+			return err
+		}
+		hdr.SetAlign(align)
+	}
+	if len(cp.Format) > 0 {
+		format, ok := formats[cp.Format]
+		if !ok {
+			return fmt.Errorf("unknown format: %s", cp.Format)
+		}
+		hdr.SetFormat(format)
+	}
+	if cp.Width > 0 {
+		hdr.SetWidth(cp.Width)
+	}
+	if cp.MinWidth > 0 {
+		hdr.SetMinWidth(cp.MinWidth)
+	}
+	if cp.Hidden {
+		t.HideColumn(idx)
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestProfile(t *testing.T) {
+	profile, err := LoadProfile(strings.NewReader(`{
+  "style": "ascii",
+  "columns": [
+    {"name": "Income", "align": "TR", "minWidth": 8},
+    {"index": 2, "hidden": true}
+  ]
+}`))
+	if err != nil {
+		t.Fatalf("LoadProfile failed: %s", err)
+	}
+
+	tab := New(Unicode)
+	tab.Header("Year")
+	tab.Header("Income")
+	tab.Header("Source")
+	if err := profile.Apply(tab); err != nil {
+		t.Fatalf("Apply failed: %s", err)
+	}
+
+	row := tab.Row()
+	row.Column("2018")
+	row.Column("100")
+	row.Column("Salary")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +------+----------+
+        | Year |   Income |
+        +------+----------+
+        | 2018 |      100 |
+        +------+----------+
+`
+	match(t, sb.String(), expected, "TestProfile")
+}
+
+func TestProfileErrors(t *testing.T) {
+	tests := []string{
+		`{"style": "fancy"}`,
+		`{"columns": [{"name": "Missing"}]}`,
+		`{"columns": [{"name": "Year", "align": "XX"}]}`,
+		`{"columns": [{"name": "Year", "format": "blink"}]}`,
+	}
+	for _, test := range tests {
+		profile, err := LoadProfile(strings.NewReader(test))
+		if err != nil {
+			t.Fatalf("LoadProfile failed: %s", err)
+		}
+		tab := New(Plain)
+		tab.Header("Year")
+		if err := profile.Apply(tab); err == nil {
+			t.Errorf("Apply(%s) succeeded", test)
+		}
+	}
+	_, err := LoadProfile(strings.NewReader(`{"colour": "red"}`))
+	if err == nil {
+		t.Errorf("LoadProfile accepted unknown field")
+	}
+}
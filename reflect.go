@@ -8,9 +8,11 @@ package tabulate
 
 import (
 	"encoding"
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
+	"strconv"
 	"strings"
 )
 
@@ -20,19 +22,209 @@ type Flags int
 
 // Flag values for reflection tabulation.
 const (
+	// OmitEmpty omits empty values, such as nil pointers, empty
+	// strings, and empty maps.
 	OmitEmpty Flags = 1 << iota
+	// InheritHeaders adds the tabulator's headers to the nested
+	// tables. Without the flag, the nested tables have no headers
+	// but they inherit the header alignments as their column
+	// defaults.
 	InheritHeaders
+	// FlattenEmbedded promotes the fields of embedded structs into
+	// the parent struct's table like encoding/json does.
+	FlattenEmbedded
+	// Stringer renders values implementing fmt.Stringer with their
+	// String method unless they implement encoding.TextMarshaler.
+	Stringer
+	// PreferStringer renders values implementing fmt.Stringer with
+	// their String method even if they implement
+	// encoding.TextMarshaler.
+	PreferStringer
+	// OmitZero omits struct fields which have zero values, such as
+	// 0, false, and empty strings. It implies OmitEmpty.
+	OmitZero
+	// Flatten renders nested structs and maps as dotted keys in a
+	// flat two-column table.
+	Flatten
+	// UnwrapErrors renders the wrapped errors of error values on
+	// their own lines.
+	UnwrapErrors
 )
 
-const nilLabel = "<nil>"
+const (
+	nilLabel   = "<nil>"
+	depthLabel = "{...}"
+	sliceWidth = 40
+)
+
+// MapOrder specifies how map keys are ordered in reflection
+// tabulation.
+type MapOrder int
+
+// Map key orders.
+const (
+	// MapLexical sorts keys by their string values.
+	MapLexical MapOrder = iota
+	// MapNumeric sorts numeric keys by their values and before
+	// non-numeric keys.
+	MapNumeric
+	// MapInsertion keeps the keys of OrderedMap values in their
+	// insertion order. Other maps are sorted lexically.
+	MapInsertion
+)
+
+// FieldOrder specifies how struct fields are ordered in reflection
+// tabulation. Fields with weight tags are always ordered by their
+// weights, heavier fields first.
+type FieldOrder int
+
+// Struct field orders.
+const (
+	// FieldDeclaration keeps the fields in their declaration order.
+	FieldDeclaration FieldOrder = iota
+	// FieldAlphabetical sorts the fields by their labels.
+	FieldAlphabetical
+)
+
+// OrderedMap is implemented by map types which remember the insertion
+// order of their keys.
+type OrderedMap interface {
+	// Keys returns the map keys in their insertion order.
+	Keys() []interface{}
+}
+
+// ReflectOpts specify reflection tabulation options.
+type ReflectOpts struct {
+	// Flags control how different values are handled.
+	Flags Flags
+	// Tags lists the element tags which are included in
+	// reflection. If the element does not have tabulation tag, then
+	// it is always included in tabulation.
+	Tags []string
+	// NilLabel is the label for nil values. If unset, nil values are
+	// rendered as "<nil>".
+	NilLabel string
+	// FieldOrder specifies how struct fields are ordered.
+	FieldOrder FieldOrder
+	// MapOrder specifies how map keys are ordered.
+	MapOrder MapOrder
+	// Descending reverses the map key order.
+	Descending bool
+	// Less, if set, sorts the map keys and overrides MapOrder.
+	Less Less
+	// SliceWidth is the maximum line width of numeric slices. If
+	// unset, numeric slices are wrapped at 40 characters.
+	SliceWidth int
+	// SliceSeparator separates the numeric slice elements on the same
+	// line. If unset, the elements are separated by a space
+	// character.
+	SliceSeparator string
+	// MaxElements limits the number of slice elements and map
+	// entries. The omitted elements are summarized with a trailer
+	// element. The value 0 means unlimited elements.
+	MaxElements int
+	// MaxDepth limits the nesting of struct and map tables. The
+	// values nested deeper than MaxDepth levels are rendered as
+	// "{...}". The value 0 means unlimited depth.
+	MaxDepth int
+}
+
+// SetReflectOpts sets the reflection tabulation options. The options
+// are used by Reflect, which overrides the options' Flags and Tags
+// with its arguments.
+func (t *Tabulate) SetReflectOpts(opts ReflectOpts) {
+	t.reflectOpts = opts
+}
+
+// nilLabel returns the label for nil values.
+func (t *Tabulate) nilLabel() string {
+	if len(t.reflectOpts.NilLabel) > 0 {
+		return t.reflectOpts.NilLabel
+	}
+	return nilLabel
+}
+
+// ReflectMarshaler converts the value v into tabulation data.
+type ReflectMarshaler func(v interface{}) (Data, error)
+
+// Registered reflection marshalers. The registry is not protected
+// against concurrent modification so the marshalers should be
+// registered during the program initialization.
+var marshalers = make(map[reflect.Type]ReflectMarshaler)
+
+// RegisterReflectMarshaler registers the marshaler function fn for
+// the type typ. Reflect uses the registered marshalers before its
+// built-in conversions so the function can be used to render types
+// which do not implement encoding.TextMarshaler, or whose text
+// encoding is not suitable for tabulation. Registering a nil function
+// removes the type's marshaler.
+func RegisterReflectMarshaler(typ reflect.Type,
+	fn func(v interface{}) (Data, error)) {
+
+	if fn == nil {
+		delete(marshalers, typ)
+	} else {
+		marshalers[typ] = fn
+	}
+}
+
+// marshaler returns the registered marshaler for the value.
+func marshaler(value reflect.Value) ReflectMarshaler {
+	if !value.IsValid() || !value.CanInterface() {
+		return nil
+	}
+	return marshalers[value.Type()]
+}
+
+// reflectSub creates a new tabulator for a nested table. The nested
+// table shares the presentation configuration of the tabulator tab.
+// Unless the flags contain InheritHeaders, the nested table does not
+// have headers but uses the header alignments as its column defaults.
+func reflectSub(tab *Tabulate, flags Flags) *Tabulate {
+	sub := tab.Clone()
+	sub.depth = tab.depth + 1
+	if flags&InheritHeaders == 0 {
+		if len(tab.Headers) > 0 {
+			sub.Defaults = nil
+			for idx, hdr := range tab.Headers {
+				sub.SetDefaults(idx, hdr.Align)
+			}
+		}
+		sub.Headers = nil
+	}
+	return sub
+}
+
+// nested tests if reflection can descend into nested tables.
+func (t *Tabulate) nested() bool {
+	return t.reflectOpts.MaxDepth <= 0 || t.depth < t.reflectOpts.MaxDepth
+}
 
 // Reflect tabulates the value into the tabulation object. The flags
 // control how different values are handled. The tags lists element
 // tags which are included in reflection. If the element does not have
-// tabulation tag, then it is always included in tabulation.
+// tabulation tag, then it is always included in tabulation. The other
+// reflection options are taken from the tabulator's ReflectOpts.
 func Reflect(tab *Tabulate, flags Flags, tags []string, v interface{}) error {
+	opts := tab.reflectOpts
+	opts.Flags = flags
+	opts.Tags = tags
+	return ReflectWith(tab, opts, v)
+}
+
+// ReflectWith tabulates the value into the tabulation object with the
+// reflection options opts. The options apply only to this call and
+// the tabulator's ReflectOpts are restored when the function returns.
+func ReflectWith(tab *Tabulate, opts ReflectOpts, v interface{}) error {
+	saved := tab.reflectOpts
+	tab.reflectOpts = opts
+	defer func() {
+		tab.reflectOpts = saved
+	}()
+	flags := opts.Flags
+
 	tagMap := make(map[string]bool)
-	for _, tag := range tags {
+	for _, tag := range opts.Tags {
 		tagMap[tag] = true
 	}
 
@@ -46,7 +238,7 @@ func Reflect(tab *Tabulate, flags Flags, tags []string, v interface{}) error {
 		value = reflect.Indirect(value)
 	}
 
-	if value.Type().Kind() == reflect.Struct {
+	if value.Type().Kind() == reflect.Struct && marshaler(value) == nil {
 		return reflectStruct(tab, flags, tagMap, value)
 	}
 	if value.Type().Kind() == reflect.Map {
@@ -100,22 +292,30 @@ func Array(tab *Tabulate, v [][]interface{}) (*Tabulate, error) {
 func reflectValue(tab *Tabulate, flags Flags, tags map[string]bool,
 	value reflect.Value) (Data, error) {
 
-	if value.CanInterface() {
-		switch v := value.Interface().(type) {
-		case encoding.TextMarshaler:
-			data, err := v.MarshalText()
-			if err != nil {
-				return nil, err
-			}
-			return NewLinesData([]string{string(data)}), nil
-		}
+	if fn := marshaler(value); fn != nil {
+		return fn(value.Interface())
+	}
+	if e := reflectError(value); e != nil {
+		return NewLinesData(errorLines(flags, e)), nil
+	}
+	if sub := reflectTable(value); sub != nil {
+		// Nested tables are rendered as is instead of their
+		// MarshalText output.
+		return sub, nil
+	}
+	text, ok, err := reflectText(flags, value)
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		return NewLinesData([]string{text}), nil
 	}
 
 	// Resolve interfaces.
 	for value.Type().Kind() == reflect.Interface {
 		if value.IsZero() {
 			if flags&OmitEmpty == 0 {
-				return NewLinesData([]string{nilLabel}), nil
+				return NewNull(tab.nilLabel()), nil
 			}
 			return NewLinesData(nil), nil
 		}
@@ -126,11 +326,14 @@ func reflectValue(tab *Tabulate, flags Flags, tags map[string]bool,
 	for value.Type().Kind() == reflect.Ptr {
 		if value.IsZero() {
 			if flags&OmitEmpty == 0 {
-				return NewLinesData([]string{nilLabel}), nil
+				return NewNull(tab.nilLabel()), nil
 			}
 		}
 		value = reflect.Indirect(value)
 	}
+	if fn := marshaler(value); fn != nil {
+		return fn(value.Interface())
+	}
 
 	switch value.Type().Kind() {
 	case reflect.Bool:
@@ -148,10 +351,10 @@ func reflectValue(tab *Tabulate, flags Flags, tags map[string]bool,
 
 	case reflect.Map:
 		if value.Len() > 0 || flags&OmitEmpty == 0 {
-			sub := tab.Clone()
-			if flags&InheritHeaders == 0 {
-				sub.Headers = nil
+			if !tab.nested() {
+				return NewText(depthLabel), nil
 			}
+			sub := reflectSub(tab, flags)
 			err := reflectMap(sub, flags, tags, value)
 			if err != nil {
 				return nil, err
@@ -171,18 +374,25 @@ func reflectValue(tab *Tabulate, flags Flags, tags map[string]bool,
 		case reflect.Uint8:
 			return reflectByteSliceValue(tab, flags, tags, value)
 
-		case reflect.Int, reflect.Uint:
-			return reflectSliceValue(tab, flags, tags, 40, value)
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32,
+			reflect.Int64, reflect.Uint, reflect.Uint16, reflect.Uint32,
+			reflect.Uint64, reflect.Uintptr, reflect.Float32,
+			reflect.Float64:
+			width := tab.reflectOpts.SliceWidth
+			if width <= 0 {
+				width = sliceWidth
+			}
+			return reflectSliceValue(tab, flags, tags, width, value)
 
 		default:
 			return reflectSliceValue(tab, flags, tags, 0, value)
 		}
 
 	case reflect.Struct:
-		sub := tab.Clone()
-		if flags&InheritHeaders == 0 {
-			sub.Headers = nil
+		if !tab.nested() {
+			return NewText(depthLabel), nil
 		}
+		sub := reflectSub(tab, flags)
 		err := reflectStruct(sub, flags, tags, value)
 		if err != nil {
 			return nil, err
@@ -198,14 +408,102 @@ func reflectValue(tab *Tabulate, flags Flags, tags map[string]bool,
 	}
 }
 
+// reflectError returns the value as error if it implements the error
+// interface.
+func reflectError(value reflect.Value) error {
+	if !value.IsValid() || !value.CanInterface() {
+		return nil
+	}
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return nil
+		}
+	}
+	e, _ := value.Interface().(error)
+	return e
+}
+
+// errorLines returns the error message lines. With the UnwrapErrors
+// flag, each error in the error chain is on its own line.
+func errorLines(flags Flags, e error) []string {
+	if flags&UnwrapErrors == 0 {
+		return strings.Split(e.Error(), "\n")
+	}
+	var lines []string
+	for e != nil {
+		msg := e.Error()
+		next := errors.Unwrap(e)
+		if next != nil {
+			msg = strings.TrimSuffix(msg, ": "+next.Error())
+		}
+		lines = append(lines, msg)
+		e = next
+	}
+	return lines
+}
+
+// reflectTable returns the value as a tabulator if it is a non-nil
+// *Tabulate. Otherwise the function returns nil.
+func reflectTable(value reflect.Value) *Tabulate {
+	if !value.IsValid() || !value.CanInterface() {
+		return nil
+	}
+	if value.Kind() == reflect.Interface {
+		if value.IsNil() {
+			return nil
+		}
+		value = value.Elem()
+	}
+	if value.Kind() != reflect.Ptr || value.IsNil() {
+		return nil
+	}
+	sub, _ := value.Interface().(*Tabulate)
+	return sub
+}
+
+// reflectText returns the text representation of the value if it
+// implements encoding.TextMarshaler or, with the Stringer flags,
+// fmt.Stringer.
+func reflectText(flags Flags, value reflect.Value) (string, bool, error) {
+	if !value.IsValid() || !value.CanInterface() {
+		return "", false, nil
+	}
+	switch value.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if value.IsNil() {
+			return "", false, nil
+		}
+	}
+	v := value.Interface()
+	if flags&PreferStringer != 0 {
+		if s, ok := v.(fmt.Stringer); ok {
+			return s.String(), true, nil
+		}
+	}
+	if m, ok := v.(encoding.TextMarshaler); ok {
+		data, err := m.MarshalText()
+		if err != nil {
+			return "", false, err
+		}
+		return string(data), true, nil
+	}
+	if flags&(Stringer|PreferStringer) != 0 {
+		if s, ok := v.(fmt.Stringer); ok {
+			return s.String(), true, nil
+		}
+	}
+	return "", false, nil
+}
+
 func reflectByteSliceValue(tab *Tabulate, flags Flags, tags map[string]bool,
 	value reflect.Value) (Data, error) {
 
-	arr, ok := value.Interface().([]byte)
-	if !ok {
-		return nil, fmt.Errorf("reflectByteSliceValue called for %T",
-			value.Type().Kind())
+	if value.Type().Elem().Kind() != reflect.Uint8 {
+		return nil, fmt.Errorf("reflectByteSliceValue called for %v",
+			value.Type())
 	}
+	arr := value.Bytes()
 
 	const lineLength = 32
 	var lines []string
@@ -223,25 +521,37 @@ func reflectSliceValue(tab *Tabulate, flags Flags, tags map[string]bool,
 	width int, value reflect.Value) (Data, error) {
 
 	data := NewSlice(width)
+	if len(tab.reflectOpts.SliceSeparator) > 0 {
+		data.SetSeparator(tab.reflectOpts.SliceSeparator)
+	}
+	count := value.Len()
+	if limit := tab.reflectOpts.MaxElements; limit > 0 && count > limit {
+		count = limit
+	}
 loop:
-	for i := 0; i < value.Len(); i++ {
+	for i := 0; i < count; i++ {
 		v := value.Index(i)
+		if e := reflectError(v); e != nil {
+			data.Append(NewLinesData(errorLines(flags, e)))
+			continue loop
+		}
 		// Follow pointers.
 		for v.Type().Kind() == reflect.Ptr {
 			if v.IsZero() {
 				if flags&OmitEmpty == 0 {
-					data.Append(NewText(nilLabel))
+					data.Append(NewNull(tab.nilLabel()))
 				}
 				continue loop
 			}
 			v = reflect.Indirect(v)
 		}
-		switch v.Type().Kind() {
-		case reflect.Struct:
-			sub := tab.Clone()
-			if flags&InheritHeaders == 0 {
-				sub.Headers = nil
+		switch {
+		case v.Type().Kind() == reflect.Struct && marshaler(v) == nil:
+			if !tab.nested() {
+				data.Append(NewText(depthLabel))
+				continue loop
 			}
+			sub := reflectSub(tab, flags)
 			err := reflectStruct(sub, flags, tags, v)
 			if err != nil {
 				return nil, err
@@ -256,26 +566,80 @@ loop:
 			data.Append(sub)
 		}
 	}
+	if count < value.Len() {
+		data.Append(NewText(moreLabel(value.Len() - count)))
+	}
 
 	return data, nil
 }
 
+// moreLabel returns the trailer label for count omitted elements.
+func moreLabel(count int) string {
+	return fmt.Sprintf("… (+%d more)", count)
+}
+
 type row struct {
 	key Data
 	val Data
 }
 
+// mapKey converts the OrderedMap key into a value of the map key type
+// keyType. The function returns an error if the key can't be
+// converted to the key type. The numeric keys are not converted to
+// strings since the conversion would interpret them as runes.
+func mapKey(keyType reflect.Type, key interface{}) (reflect.Value, error) {
+	kv := reflect.ValueOf(key)
+	if !kv.IsValid() {
+		if keyType.Kind() == reflect.Interface {
+			return reflect.Zero(keyType), nil
+		}
+		return kv, fmt.Errorf("invalid nil map key for key type %s", keyType)
+	}
+	if kv.Type().AssignableTo(keyType) {
+		return kv, nil
+	}
+	if !kv.Type().ConvertibleTo(keyType) ||
+		(keyType.Kind() == reflect.String && kv.Kind() != reflect.String) {
+		return kv, fmt.Errorf("invalid map key %v of type %s for key type %s",
+			key, kv.Type(), keyType)
+	}
+	return kv.Convert(keyType), nil
+}
+
 func reflectMap(tab *Tabulate, flags Flags, tags map[string]bool,
 	v reflect.Value) error {
 
+	opts := tab.reflectOpts
+
+	var keys []reflect.Value
+	var ordered bool
+	if opts.MapOrder == MapInsertion && v.CanInterface() {
+		if om, ok := v.Interface().(OrderedMap); ok {
+			for _, key := range om.Keys() {
+				kv, err := mapKey(v.Type().Key(), key)
+				if err != nil {
+					return err
+				}
+				keys = append(keys, kv)
+			}
+			ordered = true
+		}
+	}
+	if !ordered {
+		keys = v.MapKeys()
+	}
+
 	var rows []row
-	iter := v.MapRange()
-	for iter.Next() {
-		keyData, err := reflectValue(tab, flags, tags, iter.Key())
+	for _, key := range keys {
+		val := v.MapIndex(key)
+		if !val.IsValid() {
+			continue
+		}
+		keyData, err := reflectValue(tab, flags, tags, key)
 		if err != nil {
 			return err
 		}
-		valData, err := reflectValue(tab, flags, tags, iter.Value())
+		valData, err := reflectValue(tab, flags, tags, val)
 		if err != nil {
 			return err
 		}
@@ -285,50 +649,241 @@ func reflectMap(tab *Tabulate, flags Flags, tags map[string]bool,
 		})
 	}
 
-	sort.Slice(rows, func(i, j int) bool {
-		di := rows[i].key
-		dj := rows[j].key
-
-		height := di.Height()
-		if dj.Height() < height {
-			height = dj.Height()
+	if !ordered || opts.Less != nil {
+		compare := compareData
+		if opts.MapOrder == MapNumeric {
+			compare = compareNumeric
 		}
-
-		for row := 0; row < height; row++ {
-			cmp := strings.Compare(di.Content(row), dj.Content(row))
-			switch cmp {
-			case -1:
-				return true
-			case 1:
-				return false
+		sort.SliceStable(rows, func(i, j int) bool {
+			a, b := rows[i], rows[j]
+			if opts.Descending {
+				a, b = b, a
 			}
+			if opts.Less != nil {
+				return opts.Less(a.key, b.key)
+			}
+			cmp := compare(a.key, b.key)
+			if !tab.Deterministic {
+				return cmp < 0
+			}
+			if cmp == 0 {
+				cmp = compareData(a.val, b.val)
+			}
+			if cmp == 0 {
+				cmp = strings.Compare(a.val.String(), b.val.String())
+			}
+			return cmp < 0
+		})
+	} else if opts.Descending {
+		for i, j := 0, len(rows)-1; i < j; i, j = i+1, j-1 {
+			rows[i], rows[j] = rows[j], rows[i]
 		}
-		if di.Height() <= dj.Height() {
-			return true
-		}
-		return false
-	})
+	}
+
+	var more int
+	if limit := opts.MaxElements; limit > 0 && len(rows) > limit {
+		more = len(rows) - limit
+		rows = rows[:limit]
+	}
 
 	for _, r := range rows {
+		if flags&Flatten != 0 &&
+			flattenRows(tab, r.key.String(), r.val) {
+			continue
+		}
 		row := tab.Row()
 		row.ColumnData(r.key)
 		row.ColumnData(r.val)
 	}
 
+	if more > 0 {
+		row := tab.Row()
+		row.Column(moreLabel(more))
+	}
+
 	return nil
 }
 
+// flattenRows moves the rows of the nested table data into the
+// table tab and prefixes their keys with the key label. The function
+// returns false if the data is not a nested table.
+func flattenRows(tab *Tabulate, key string, data Data) bool {
+	sub, ok := data.(*Tabulate)
+	if !ok || len(sub.Rows) == 0 {
+		return false
+	}
+	for _, r := range sub.Rows {
+		if len(r.Columns) == 0 {
+			continue
+		}
+		label := key + "." + r.Columns[0].Data.String()
+		r.Columns[0].Data = NewLines(label)
+		r.Tab = tab
+		tab.Rows = append(tab.Rows, r)
+	}
+	return true
+}
+
+// embeddedStruct tests if the embedded field type typ is a struct or
+// a pointer to a struct.
+func embeddedStruct(typ reflect.Type) bool {
+	for typ.Kind() == reflect.Ptr {
+		typ = typ.Elem()
+	}
+	return typ.Kind() == reflect.Struct
+}
+
+// fieldNames returns the labels of the struct type's fields which are
+// not promoted from embedded structs.
+func fieldNames(typ reflect.Type) map[string]bool {
+	result := make(map[string]bool)
+loop:
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name := field.Name
+		named := false
+		for _, tag := range strings.Split(field.Tag.Get("tabulate"), ",") {
+			if tag == "-" {
+				continue loop
+			} else if strings.HasPrefix(tag, "name=") {
+				name = tag[5:]
+				named = true
+			}
+		}
+		if field.Anonymous && !named && embeddedStruct(field.Type) {
+			continue
+		}
+		result[name] = true
+	}
+	return result
+}
+
+// fieldOrder returns the struct type's field indices in the
+// tabulation order. The fields are ordered by their weight tags,
+// heavier fields first, and then by the field order.
+func fieldOrder(typ reflect.Type, fieldOrder FieldOrder) ([]int, error) {
+	order := make([]int, typ.NumField())
+	weights := make([]int, typ.NumField())
+	labels := make([]string, typ.NumField())
+	for i := range order {
+		order[i] = i
+		field := typ.Field(i)
+		labels[i] = field.Name
+		for _, tag := range strings.Split(field.Tag.Get("tabulate"), ",") {
+			if strings.HasPrefix(tag, "name=") {
+				labels[i] = tag[5:]
+			} else if strings.HasPrefix(tag, "weight=") {
+				w, err := strconv.Atoi(tag[7:])
+				if err != nil {
+					return nil, fmt.Errorf("invalid weight: %s", tag[7:])
+				}
+				weights[i] = w
+			}
+		}
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := order[i], order[j]
+		if weights[a] != weights[b] {
+			return weights[a] > weights[b]
+		}
+		if fieldOrder == FieldAlphabetical {
+			return labels[a] < labels[b]
+		}
+		return false
+	})
+	return order, nil
+}
+
+// compareNumeric compares the data values numerically if both values
+// are numbers. Otherwise the values are compared with compareData.
+func compareNumeric(a, b Data) int {
+	if a.Height() == 1 && b.Height() == 1 {
+		af, aerr := strconv.ParseFloat(strings.TrimSpace(a.Content(0)), 64)
+		bf, berr := strconv.ParseFloat(strings.TrimSpace(b.Content(0)), 64)
+		switch {
+		case aerr == nil && berr == nil:
+			switch {
+			case af < bf:
+				return -1
+			case af > bf:
+				return 1
+			}
+		case aerr == nil:
+			return -1
+		case berr == nil:
+			return 1
+		}
+	}
+	return compareData(a, b)
+}
+
+// compareData compares the data values line by line. The function
+// returns -1, 0, or 1 if a is less than, equal to, or greater than b.
+func compareData(a, b Data) int {
+	height := a.Height()
+	if b.Height() < height {
+		height = b.Height()
+	}
+	for row := 0; row < height; row++ {
+		cmp := strings.Compare(a.Content(row), b.Content(row))
+		if cmp != 0 {
+			return cmp
+		}
+	}
+	switch {
+	case a.Height() < b.Height():
+		return -1
+	case a.Height() > b.Height():
+		return 1
+	default:
+		return 0
+	}
+}
+
 func reflectStruct(tab *Tabulate, flags Flags, tags map[string]bool,
 	value reflect.Value) error {
 
+	var shadowed map[string]bool
+	if flags&FlattenEmbedded != 0 {
+		shadowed = fieldNames(value.Type())
+	}
+
+	order, err := fieldOrder(value.Type(), tab.reflectOpts.FieldOrder)
+	if err != nil {
+		return err
+	}
+
 loop:
-	for i := 0; i < value.NumField(); i++ {
+	for _, i := range order {
 		field := value.Type().Field(i)
 
+		name := field.Name
+		named := false
+		align := None
+		format := FmtNone
 		myFlags := flags
 		for _, tag := range strings.Split(field.Tag.Get("tabulate"), ",") {
-			if tag == "omitempty" {
+			if tag == "-" {
+				continue loop
+			} else if strings.HasPrefix(tag, "name=") {
+				name = tag[5:]
+				named = true
+			} else if strings.HasPrefix(tag, "align=") {
+				a, err := ParseAlign(tag[6:])
+				if err != nil {
+					return err
+				}
+				align = a
+			} else if strings.HasPrefix(tag, "format=") {
+				f, ok := formats[tag[7:]]
+				if !ok {
+					return fmt.Errorf("unknown format: %s", tag[7:])
+				}
+				format = f
+			} else if tag == "omitempty" {
 				myFlags |= OmitEmpty
+			} else if tag == "omitzero" {
+				myFlags |= OmitZero
 			} else if strings.HasPrefix(tag, "@") {
 				// Tagged field. Skip unless filter tags contain it.
 				if !tags[tag[1:]] {
@@ -337,30 +892,76 @@ loop:
 			}
 		}
 
+		// setTags applies the align and format tags to the value
+		// column.
+		setTags := func(col *Column) {
+			if align != None {
+				col.SetAlign(align)
+			}
+			if format != FmtNone {
+				col.SetFormat(format)
+			}
+		}
+
 		v := value.Field(i)
+		if myFlags&OmitZero != 0 {
+			if v.IsZero() {
+				continue loop
+			}
+			myFlags |= OmitEmpty
+		}
+		flatten := flags&FlattenEmbedded != 0 && field.Anonymous && !named &&
+			embeddedStruct(field.Type)
+
+		if e := reflectError(v); e != nil {
+			row := tab.Row()
+			row.Column(name)
+			row.ColumnData(NewLinesData(errorLines(myFlags, e)))
+			continue loop
+		}
 
 		// Follow pointers.
 		for v.Type().Kind() == reflect.Ptr {
 			if v.IsZero() {
-				if myFlags&OmitEmpty == 0 {
+				if myFlags&OmitEmpty == 0 && !flatten {
 					row := tab.Row()
-					row.Column(field.Name)
+					row.Column(name)
+					setTags(row.ColumnData(NewNull(tab.nilLabel())))
 				}
 				continue loop
 			}
 			v = reflect.Indirect(v)
 		}
 
-		if v.CanInterface() {
-			switch iv := v.Interface().(type) {
-			case encoding.TextMarshaler:
-				data, err := iv.MarshalText()
-				if err != nil {
-					return err
+		if flatten && marshaler(v) == nil {
+			// Promote the embedded struct's fields unless the
+			// parent struct defines fields with the same names.
+			sub := tab.Clone()
+			sub.depth = tab.depth
+			err := reflectStruct(sub, myFlags, tags, v)
+			if err != nil {
+				return err
+			}
+			for _, r := range sub.Rows {
+				if len(r.Columns) > 0 &&
+					shadowed[r.Columns[0].Data.String()] {
+					continue
 				}
+				r.Tab = tab
+				tab.Rows = append(tab.Rows, r)
+			}
+			continue loop
+		}
+
+		if marshaler(v) == nil {
+			text, ok, err := reflectText(myFlags, v)
+			if err != nil {
+				return err
+			}
+			if ok {
 				row := tab.Row()
-				row.Column(field.Name)
-				row.Column(string(data))
+				row.Column(name)
+				setTags(row.Column(text))
 				continue loop
 			}
 		}
@@ -369,10 +970,13 @@ loop:
 		if err != nil {
 			return err
 		}
+		if flags&Flatten != 0 && flattenRows(tab, name, data) {
+			continue loop
+		}
 		if data.Height() > 0 || flags&OmitEmpty == 0 {
 			row := tab.Row()
-			row.Column(field.Name)
-			row.ColumnData(data)
+			row.Column(name)
+			setTags(row.ColumnData(data))
 		}
 
 	}
@@ -10,8 +10,10 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"fmt"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
 )
 
 type Outer struct {
@@ -82,20 +84,20 @@ func TestReflect(t *testing.T) {
         │     Age │ 45                                 │
         │     NPS │ 0                                  │
         │         │ ┌───────┬─────────────────┐        │
-        │         │ │ Lines │ 42 Hacker way   │        │
-        │ Address │ │       │ 03139 Cambridge │        │
+        │         │ │       │ 42 Hacker way   │        │
+        │ Address │ │ Lines │ 03139 Cambridge │        │
         │         │ │       │ MA              │        │
         │         │ └───────┴─────────────────┘        │
         │         │ ┌───────┬────────────┐             │
         │         │ │ Email │ mtr@iki.fi │             │
-        │         │ │ Work  │ false      │             │
+        │         │ │  Work │ false      │             │
         │    Info │ └───────┴────────────┘             │
         │         │ ┌───────┬────────────────────────┐ │
         │         │ │ Email │ markku.rossi@gmail.com │ │
-        │         │ │ Work  │ true                   │ │
+        │         │ │  Work │ true                   │ │
         │         │ └───────┴────────────────────────┘ │
         │         │ ┌────────┬─────┐                   │
-        │ Mapping │ │ First  │ 1st │                   │
+        │ Mapping │ │  First │ 1st │                   │
         │         │ │ Second │ 2nd │                   │
         │         │ └────────┴─────┘                   │
         └─────────┴────────────────────────────────────┘
@@ -127,7 +129,7 @@ func TestReflect(t *testing.T) {
         │   NPS │ 0                                  │
         │       │ ┌───────┬────────────────────────┐ │
         │  Info │ │ Email │ markku.rossi@gmail.com │ │
-        │       │ │ Work  │ true                   │ │
+        │       │ │  Work │ true                   │ │
         │       │ └───────┴────────────────────────┘ │
         └───────┴────────────────────────────────────┘
 `, "TestReflect 2")
@@ -147,7 +149,7 @@ func TestReflect(t *testing.T) {
         │         │ <nil>                                             │
         │         │ ┌───────┬────────────────────────┐                │
         │    Info │ │ Email │ markku.rossi@gmail.com │                │
-        │         │ │ Work  │ true                   │                │
+        │         │ │  Work │ true                   │                │
         │         │ └───────┴────────────────────────┘                │
         │ Mapping │                                                   │
         └─────────┴───────────────────────────────────────────────────┘
@@ -305,3 +307,823 @@ func TestReflectArray(t *testing.T) {
 
 	match(t, sb.String(), expected, "TestReflectArray")
 }
+
+func TestReflectDeterministic(t *testing.T) {
+	v := map[interface{}]string{
+		1:   "int",
+		"1": "string",
+		1.0: "float",
+	}
+	expected := `
+        +---+--------+
+        | 1 | float  |
+        | 1 | int    |
+        | 1 | string |
+        +---+--------+
+`
+	for i := 0; i < 10; i++ {
+		tab := New(ASCII)
+		tab.SetDeterministic(true)
+		err := Reflect(tab, 0, nil, v)
+		if err != nil {
+			t.Fatalf("Reflect failed: %s", err)
+		}
+		var sb strings.Builder
+		tab.Print(&sb)
+		match(t, sb.String(), expected, "TestReflectDeterministic")
+	}
+}
+
+func TestReflectTagName(t *testing.T) {
+	tab := New(ASCII)
+	err := Reflect(tab, 0, nil, struct {
+		Host   string `tabulate:"name=Host Name"`
+		Secret string `tabulate:"-"`
+		Port   int
+	}{
+		Host:   "localhost",
+		Secret: "xyzzy",
+		Port:   8080,
+	})
+	if err != nil {
+		t.Fatalf("Reflect failed: %s", err)
+	}
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +-----------+-----------+
+        | Host Name | localhost |
+        | Port      | 8080      |
+        +-----------+-----------+
+`
+	match(t, sb.String(), expected, "TestReflectTagName")
+}
+
+func TestReflectTagAlign(t *testing.T) {
+	tab := New(ASCII)
+	err := Reflect(tab, 0, nil, struct {
+		Name  string
+		Count int `tabulate:"align=MR,format=bold"`
+	}{
+		Name:  "requests",
+		Count: 42,
+	})
+	if err != nil {
+		t.Fatalf("Reflect failed: %s", err)
+	}
+	col := tab.Rows[1].Columns[1]
+	if col.Align != MR {
+		t.Errorf("TestReflectTagAlign: align %s, expected %s", col.Align, MR)
+	}
+	if col.Format != FmtBold {
+		t.Errorf("TestReflectTagAlign: format %v, expected %v",
+			col.Format, FmtBold)
+	}
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +-------+----------+
+        | Name  | requests |
+        | Count |       ` + FmtBold.VT100() + `42` + FmtNone.VT100() + ` |
+        +-------+----------+
+`
+	match(t, sb.String(), expected, "TestReflectTagAlign")
+
+	tab = New(ASCII)
+	err = Reflect(tab, 0, nil, struct {
+		Name  string
+		Count *int `tabulate:"align=MR,format=bold"`
+	}{
+		Name: "requests",
+	})
+	if err != nil {
+		t.Fatalf("Reflect failed: %s", err)
+	}
+	col = tab.Rows[1].Columns[1]
+	if col.Align != MR || col.Format != FmtBold {
+		t.Errorf("TestReflectTagAlign: nil align %s format %v, expected %s %v",
+			col.Align, col.Format, MR, FmtBold)
+	}
+
+	err = Reflect(New(ASCII), 0, nil, struct {
+		Count int `tabulate:"align=XX"`
+	}{})
+	if err == nil {
+		t.Errorf("TestReflectTagAlign: unknown alignment accepted")
+	}
+}
+
+type orderedMap map[string]int
+
+func (m orderedMap) Keys() []interface{} {
+	return []interface{}{"c", "a", "b"}
+}
+
+func TestReflectMapOrder(t *testing.T) {
+	tests := []struct {
+		opts     ReflectOpts
+		v        interface{}
+		expected string
+	}{
+		{
+			opts: ReflectOpts{},
+			v:    map[int]string{2: "two", 10: "ten", 1: "one"},
+			expected: `
+        +----+-----+
+        | 1  | one |
+        | 10 | ten |
+        | 2  | two |
+        +----+-----+
+`,
+		},
+		{
+			opts: ReflectOpts{
+				MapOrder: MapNumeric,
+			},
+			v: map[int]string{2: "two", 10: "ten", 1: "one"},
+			expected: `
+        +----+-----+
+        | 1  | one |
+        | 2  | two |
+        | 10 | ten |
+        +----+-----+
+`,
+		},
+		{
+			opts: ReflectOpts{
+				MapOrder:   MapNumeric,
+				Descending: true,
+			},
+			v: map[int]string{2: "two", 10: "ten", 1: "one"},
+			expected: `
+        +----+-----+
+        | 10 | ten |
+        | 2  | two |
+        | 1  | one |
+        +----+-----+
+`,
+		},
+		{
+			opts: ReflectOpts{
+				MapOrder: MapInsertion,
+			},
+			v: orderedMap{"a": 1, "b": 2, "c": 3},
+			expected: `
+        +---+---+
+        | c | 3 |
+        | a | 1 |
+        | b | 2 |
+        +---+---+
+`,
+		},
+		{
+			opts: ReflectOpts{
+				Less: func(a, b Data) bool {
+					return len(a.String()) < len(b.String())
+				},
+			},
+			v: map[string]int{"ccc": 3, "a": 1, "bb": 2},
+			expected: `
+        +-----+---+
+        | a   | 1 |
+        | bb  | 2 |
+        | ccc | 3 |
+        +-----+---+
+`,
+		},
+	}
+	for idx, test := range tests {
+		tab := New(ASCII)
+		tab.SetReflectOpts(test.opts)
+		err := Reflect(tab, 0, nil, test.v)
+		if err != nil {
+			t.Fatalf("Reflect failed: %s", err)
+		}
+		var sb strings.Builder
+		tab.Print(&sb)
+		match(t, sb.String(), test.expected,
+			fmt.Sprintf("TestReflectMapOrder-%d", idx))
+	}
+}
+
+type mapKeyName string
+
+// keyedMap returns the keys of keyedMapKeys as its insertion order.
+type keyedMap map[mapKeyName]int
+
+var keyedMapKeys []interface{}
+
+func (m keyedMap) Keys() []interface{} {
+	return keyedMapKeys
+}
+
+func TestReflectMapInsertionKeys(t *testing.T) {
+	defer func() {
+		keyedMapKeys = nil
+	}()
+	m := keyedMap{"a": 1, "b": 2}
+	opts := ReflectOpts{
+		MapOrder: MapInsertion,
+	}
+
+	keyedMapKeys = []interface{}{"b", mapKeyName("a")}
+	tab := New(ASCII)
+	if err := ReflectWith(tab, opts, m); err != nil {
+		t.Fatalf("ReflectWith failed: %s", err)
+	}
+	match(t, tab.String(), `
+        +---+---+
+        | b | 2 |
+        | a | 1 |
+        +---+---+
+`, "TestReflectMapInsertionKeys")
+
+	for _, keys := range [][]interface{}{{nil}, {1}, {1.5}} {
+		keyedMapKeys = keys
+		if err := ReflectWith(New(ASCII), opts, m); err == nil {
+			t.Errorf("ReflectWith accepted map keys %v", keys)
+		}
+	}
+}
+
+func TestReflectMarshaler(t *testing.T) {
+	RegisterReflectMarshaler(reflect.TypeOf(time.Time{}),
+		func(v interface{}) (Data, error) {
+			return NewText(v.(time.Time).Format("2006-01-02")), nil
+		})
+	defer RegisterReflectMarshaler(reflect.TypeOf(time.Time{}), nil)
+
+	created := time.Date(2026, 10, 16, 12, 0, 0, 0, time.UTC)
+	tab := New(ASCII)
+	err := Reflect(tab, 0, nil, struct {
+		Created  time.Time
+		Modified *time.Time
+		History  []time.Time
+	}{
+		Created:  created,
+		Modified: &created,
+		History:  []time.Time{created},
+	})
+	if err != nil {
+		t.Fatalf("Reflect failed: %s", err)
+	}
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +----------+------------+
+        | Created  | 2026-10-16 |
+        | Modified | 2026-10-16 |
+        | History  | 2026-10-16 |
+        +----------+------------+
+`
+	match(t, sb.String(), expected, "TestReflectMarshaler")
+}
+
+func TestReflectMaxDepth(t *testing.T) {
+	type Leaf struct {
+		Value int
+	}
+	type Branch struct {
+		Leaf  Leaf
+		Items []Leaf
+	}
+	type Root struct {
+		Name   string
+		Branch Branch
+	}
+
+	tab := New(ASCII)
+	tab.SetReflectOpts(ReflectOpts{
+		MaxDepth: 1,
+	})
+	err := Reflect(tab, 0, nil, &Root{
+		Name: "root",
+		Branch: Branch{
+			Items: []Leaf{{Value: 1}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("Reflect failed: %s", err)
+	}
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +--------+-------------------+
+        | Name   | root              |
+        | Branch | +-------+-------+ |
+        |        | | Leaf  | {...} | |
+        |        | | Items | {...} | |
+        |        | +-------+-------+ |
+        +--------+-------------------+
+`
+	match(t, sb.String(), expected, "TestReflectMaxDepth")
+}
+
+func TestReflectFlattenEmbedded(t *testing.T) {
+	type Base struct {
+		ID   int
+		Name string
+	}
+	type Meta struct {
+		Tags string
+	}
+	type Item struct {
+		Base
+		*Meta
+		Name  string
+		Count int
+	}
+
+	tab := New(ASCII)
+	err := Reflect(tab, FlattenEmbedded, nil, &Item{
+		Base: Base{
+			ID:   1,
+			Name: "base",
+		},
+		Name:  "item",
+		Count: 42,
+	})
+	if err != nil {
+		t.Fatalf("Reflect failed: %s", err)
+	}
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +-------+------+
+        | ID    | 1    |
+        | Name  | item |
+        | Count | 42   |
+        +-------+------+
+`
+	match(t, sb.String(), expected, "TestReflectFlattenEmbedded")
+}
+
+type level int
+
+func (l level) String() string {
+	return [...]string{"debug", "info", "warn"}[l]
+}
+
+type textLevel int
+
+func (l textLevel) String() string {
+	return "string"
+}
+
+func (l textLevel) MarshalText() ([]byte, error) {
+	return []byte("text"), nil
+}
+
+func TestReflectStringer(t *testing.T) {
+	v := struct {
+		Level   level
+		Timeout time.Duration
+		Both    textLevel
+	}{
+		Level:   1,
+		Timeout: 90 * time.Second,
+	}
+	tests := []struct {
+		flags    Flags
+		expected string
+	}{
+		{
+			flags: 0,
+			expected: `
+        +---------+-------------+
+        | Level   | 1           |
+        | Timeout | 90000000000 |
+        | Both    | text        |
+        +---------+-------------+
+`,
+		},
+		{
+			flags: Stringer,
+			expected: `
+        +---------+-------+
+        | Level   | info  |
+        | Timeout | 1m30s |
+        | Both    | text  |
+        +---------+-------+
+`,
+		},
+		{
+			flags: PreferStringer,
+			expected: `
+        +---------+--------+
+        | Level   | info   |
+        | Timeout | 1m30s  |
+        | Both    | string |
+        +---------+--------+
+`,
+		},
+	}
+	for idx, test := range tests {
+		tab := New(ASCII)
+		err := Reflect(tab, test.flags, nil, v)
+		if err != nil {
+			t.Fatalf("Reflect failed: %s", err)
+		}
+		var sb strings.Builder
+		tab.Print(&sb)
+		match(t, sb.String(), test.expected,
+			fmt.Sprintf("TestReflectStringer-%d", idx))
+	}
+}
+
+func TestReflectWith(t *testing.T) {
+	type Item struct {
+		Name    string
+		Comment string `tabulate:"@detail"`
+		Parent  *Item
+	}
+
+	tab := New(ASCII)
+	err := ReflectWith(tab, ReflectOpts{
+		Tags:     []string{"detail"},
+		NilLabel: "-",
+	}, &Item{
+		Name:    "item",
+		Comment: "comment",
+		Parent:  nil,
+	})
+	if err != nil {
+		t.Fatalf("ReflectWith failed: %s", err)
+	}
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +---------+---------+
+        | Name    | item    |
+        | Comment | comment |
+        | Parent  | -       |
+        +---------+---------+
+`
+	match(t, sb.String(), expected, "TestReflectWith")
+
+	tab = New(ASCII)
+	err = ReflectWith(tab, ReflectOpts{
+		NilLabel: "-",
+	}, []interface{}{"a", nil})
+	if err != nil {
+		t.Fatalf("ReflectWith failed: %s", err)
+	}
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        +--+---+
+        |  | a |
+        |  | - |
+        +--+---+
+`
+	match(t, sb.String(), expected, "TestReflectWith-nil")
+
+	if len(tab.reflectOpts.NilLabel) > 0 {
+		t.Errorf("ReflectWith changed ReflectOpts: %v", tab.reflectOpts)
+	}
+}
+
+func TestReflectFieldOrder(t *testing.T) {
+	type Status struct {
+		Version string
+		Uptime  int
+		Name    string `tabulate:"weight=10"`
+		Errors  int    `tabulate:"name=Failures,weight=5"`
+	}
+	v := &Status{
+		Uptime:  3600,
+		Version: "1.2.3",
+		Name:    "server",
+		Errors:  2,
+	}
+	tests := []struct {
+		order    FieldOrder
+		expected string
+	}{
+		{
+			order: FieldDeclaration,
+			expected: `
+        +----------+--------+
+        | Name     | server |
+        | Failures | 2      |
+        | Version  | 1.2.3  |
+        | Uptime   | 3600   |
+        +----------+--------+
+`,
+		},
+		{
+			order: FieldAlphabetical,
+			expected: `
+        +----------+--------+
+        | Name     | server |
+        | Failures | 2      |
+        | Uptime   | 3600   |
+        | Version  | 1.2.3  |
+        +----------+--------+
+`,
+		},
+	}
+	for idx, test := range tests {
+		tab := New(ASCII)
+		err := ReflectWith(tab, ReflectOpts{
+			FieldOrder: test.order,
+		}, v)
+		if err != nil {
+			t.Fatalf("ReflectWith failed: %s", err)
+		}
+		var sb strings.Builder
+		tab.Print(&sb)
+		match(t, sb.String(), test.expected,
+			fmt.Sprintf("TestReflectFieldOrder-%d", idx))
+	}
+
+	tab := New(ASCII)
+	err := ReflectWith(tab, ReflectOpts{
+		FieldOrder: FieldAlphabetical,
+	}, struct {
+		Zeta  int
+		Alpha int
+		Mu    int `tabulate:"name=Beta"`
+	}{})
+	if err != nil {
+		t.Fatalf("ReflectWith failed: %s", err)
+	}
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +-------+---+
+        | Alpha | 0 |
+        | Beta  | 0 |
+        | Zeta  | 0 |
+        +-------+---+
+`
+	match(t, sb.String(), expected, "TestReflectFieldOrder-alpha")
+}
+
+func TestReflectOmitZero(t *testing.T) {
+	type Status struct {
+		Name    string
+		Errors  int
+		Healthy bool
+		Load    float64
+		Uptime  int `tabulate:"omitzero"`
+	}
+	v := &Status{
+		Name: "server",
+		Load: 0.5,
+	}
+
+	tab := New(ASCII)
+	err := Reflect(tab, OmitEmpty, nil, v)
+	if err != nil {
+		t.Fatalf("Reflect failed: %s", err)
+	}
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +---------+--------+
+        | Name    | server |
+        | Errors  | 0      |
+        | Healthy | false  |
+        | Load    | 0.5    |
+        +---------+--------+
+`
+	match(t, sb.String(), expected, "TestReflectOmitZero-empty")
+
+	tab = New(ASCII)
+	err = Reflect(tab, OmitZero, nil, v)
+	if err != nil {
+		t.Fatalf("Reflect failed: %s", err)
+	}
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        +------+--------+
+        | Name | server |
+        | Load | 0.5    |
+        +------+--------+
+`
+	match(t, sb.String(), expected, "TestReflectOmitZero")
+}
+
+func TestReflectFlatten(t *testing.T) {
+	type HTTP struct {
+		Port int
+		TLS  bool
+	}
+	type Server struct {
+		Name string
+		HTTP HTTP
+	}
+	v := map[string]interface{}{
+		"server": Server{
+			Name: "www",
+			HTTP: HTTP{
+				Port: 8080,
+			},
+		},
+		"debug": false,
+	}
+
+	tab := New(ASCII)
+	err := Reflect(tab, Flatten, nil, v)
+	if err != nil {
+		t.Fatalf("Reflect failed: %s", err)
+	}
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +------------------+-------+
+        | debug            | false |
+        | server.Name      | www   |
+        | server.HTTP.Port | 8080  |
+        | server.HTTP.TLS  | false |
+        +------------------+-------+
+`
+	match(t, sb.String(), expected, "TestReflectFlatten")
+}
+
+func TestReflectNumericSlice(t *testing.T) {
+	v := struct {
+		Ints   []int16
+		Floats []float64
+	}{
+		Ints:   []int16{1, 2, 3, 4, 5, 6, 7, 8, 9, 10},
+		Floats: []float64{0.5, 1.5, 2.5},
+	}
+
+	tab := New(ASCII)
+	err := ReflectWith(tab, ReflectOpts{
+		SliceWidth:     12,
+		SliceSeparator: ", ",
+	}, v)
+	if err != nil {
+		t.Fatalf("ReflectWith failed: %s", err)
+	}
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +--------+------------+
+        | Ints   | 1, 2, 3, 4 |
+        |        | 5, 6, 7, 8 |
+        |        | 9, 10      |
+        | Floats | 0.5, 1.5   |
+        |        | 2.5        |
+        +--------+------------+
+`
+	match(t, sb.String(), expected, "TestReflectNumericSlice")
+}
+
+func TestReflectMaxElements(t *testing.T) {
+	v := struct {
+		Values []int
+		Names  []string
+		Map    map[string]int
+	}{
+		Values: []int{1, 2, 3, 4, 5},
+		Names:  []string{"a", "b"},
+		Map:    map[string]int{"a": 1, "b": 2, "c": 3},
+	}
+
+	tab := New(ASCII)
+	err := ReflectWith(tab, ReflectOpts{
+		MaxElements: 2,
+	}, v)
+	if err != nil {
+		t.Fatalf("ReflectWith failed: %s", err)
+	}
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +--------+---------------------+
+        | Values | 1 2 … (+3 more)     |
+        | Names  | a                   |
+        |        | b                   |
+        | Map    | +-------------+---+ |
+        |        | | a           | 1 | |
+        |        | | b           | 2 | |
+        |        | | … (+1 more) |   | |
+        |        | +-------------+---+ |
+        +--------+---------------------+
+`
+	match(t, sb.String(), expected, "TestReflectMaxElements")
+}
+
+func TestReflectInheritHeaders(t *testing.T) {
+	type Inner struct {
+		Key string
+	}
+	v := struct {
+		Name  string
+		Inner Inner
+	}{
+		Name: "outer",
+		Inner: Inner{
+			Key: "inner",
+		},
+	}
+	tests := []struct {
+		flags    Flags
+		expected string
+	}{
+		{
+			flags: 0,
+			expected: `
+        +-------+-----------------+
+        | Field | Value           |
+        +-------+-----------------+
+        |  Name | outer           |
+        |       | +-----+-------+ |
+        | Inner | | Key | inner | |
+        |       | +-----+-------+ |
+        +-------+-----------------+
+`,
+		},
+		{
+			flags: InheritHeaders,
+			expected: `
+        +-------+-------------------+
+        | Field | Value             |
+        +-------+-------------------+
+        |  Name | outer             |
+        |       | +-------+-------+ |
+        |       | | Field | Value | |
+        | Inner | +-------+-------+ |
+        |       | |   Key | inner | |
+        |       | +-------+-------+ |
+        +-------+-------------------+
+`,
+		},
+	}
+	for idx, test := range tests {
+		tab := New(ASCII)
+		tab.Header("Field").SetAlign(MR)
+		tab.Header("Value")
+		err := Reflect(tab, test.flags, nil, v)
+		if err != nil {
+			t.Fatalf("Reflect failed: %s", err)
+		}
+		var sb strings.Builder
+		tab.Print(&sb)
+		match(t, sb.String(), test.expected,
+			fmt.Sprintf("TestReflectInheritHeaders-%d", idx))
+	}
+}
+
+type statusError struct {
+	code int
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("status %d", e.code)
+}
+
+func TestReflectError(t *testing.T) {
+	v := struct {
+		Err    error
+		Nil    error
+		Status *statusError
+	}{
+		Err: fmt.Errorf("fetch failed: %w",
+			fmt.Errorf("connect: %w", &statusError{code: 503})),
+		Status: &statusError{code: 404},
+	}
+	tests := []struct {
+		flags    Flags
+		expected string
+	}{
+		{
+			flags: 0,
+			expected: `
+        +--------+-----------------------------------+
+        | Err    | fetch failed: connect: status 503 |
+        | Nil    | <nil>                             |
+        | Status | status 404                        |
+        +--------+-----------------------------------+
+`,
+		},
+		{
+			flags: OmitEmpty | UnwrapErrors,
+			expected: `
+        +--------+--------------+
+        | Err    | fetch failed |
+        |        | connect      |
+        |        | status 503   |
+        | Status | status 404   |
+        +--------+--------------+
+`,
+		},
+	}
+	for idx, test := range tests {
+		tab := New(ASCII)
+		err := Reflect(tab, test.flags, nil, v)
+		if err != nil {
+			t.Fatalf("Reflect failed: %s", err)
+		}
+		var sb strings.Builder
+		tab.Print(&sb)
+		match(t, sb.String(), test.expected,
+			fmt.Sprintf("TestReflectError-%d", idx))
+	}
+}
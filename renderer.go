@@ -0,0 +1,98 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+	"io"
+	"sync"
+)
+
+// Renderer renders tables into custom output formats.
+type Renderer interface {
+	// Render renders the table into the writer.
+	Render(t *Tabulate, w io.Writer) error
+}
+
+// RendererFunc implements the Renderer interface with a function.
+type RendererFunc func(t *Tabulate, w io.Writer) error
+
+// Render implements Renderer.Render().
+func (f RendererFunc) Render(t *Tabulate, w io.Writer) error {
+	return f(t, w)
+}
+
+var (
+	renderersMu sync.Mutex
+	renderers   = make(map[string]Renderer)
+)
+
+// RegisterRenderer registers the renderer with the argument name. The
+// registered renderers can be selected with SetOutputName. The
+// function panics if the name is already used by a style or by
+// another renderer.
+func RegisterRenderer(name string, r Renderer) {
+	renderersMu.Lock()
+	defer renderersMu.Unlock()
+
+	if _, ok := Styles[name]; ok {
+		panic(fmt.Sprintf("renderer name conflicts with style: %s", name))
+	}
+	if _, ok := renderers[name]; ok {
+		panic(fmt.Sprintf("renderer already registered: %s", name))
+	}
+	renderers[name] = r
+}
+
+// LookupRenderer returns the renderer registered with the argument
+// name.
+func LookupRenderer(name string) (Renderer, bool) {
+	renderersMu.Lock()
+	defer renderersMu.Unlock()
+
+	r, ok := renderers[name]
+	return r, ok
+}
+
+// SetRenderer sets the renderer for the table output. The renderer
+// overrides the table style's own output. Setting a style with
+// SetStyle clears the renderer.
+func (t *Tabulate) SetRenderer(r Renderer) {
+	t.renderer = r
+	t.asData = nil
+}
+
+// SetOutputName sets the table output by name. The name can be a
+// style name or the name of a registered renderer.
+func (t *Tabulate) SetOutputName(name string) error {
+	if r, ok := LookupRenderer(name); ok {
+		t.SetRenderer(r)
+		return nil
+	}
+	style, err := ParseStyle(name)
+	if err != nil {
+		return err
+	}
+	t.SetStyle(style)
+	return nil
+}
+
+// errorSink is implemented by writers which record errors that are
+// not write errors, such as renderer errors. The fail function returns
+// false if the error was not recorded.
+type errorSink interface {
+	fail(err error) bool
+}
+
+// fail reports the error to the writer if it implements errorSink.
+// The function returns false if the writer did not record the error.
+func fail(w io.Writer, err error) bool {
+	if sink, ok := w.(errorSink); ok {
+		return sink.fail(err)
+	}
+	return false
+}
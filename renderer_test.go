@@ -0,0 +1,81 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+)
+
+func renderTSV(t *Tabulate, w io.Writer) error {
+	var lines []string
+	var cols []string
+	for _, hdr := range t.Headers {
+		cols = append(cols, hdr.Data.String())
+	}
+	lines = append(lines, strings.Join(cols, "\t"))
+	for _, row := range t.Rows {
+		cols = nil
+		for _, col := range row.Columns {
+			cols = append(cols, col.Data.String())
+		}
+		lines = append(lines, strings.Join(cols, "\t"))
+	}
+	_, err := fmt.Fprintln(w, strings.Join(lines, "\n"))
+	return err
+}
+
+// The renderers can't be unregistered so the test renderer is
+// registered once for all test runs.
+func init() {
+	RegisterRenderer("test-tsv", RendererFunc(renderTSV))
+}
+
+func TestRenderer(t *testing.T) {
+	tab := New(ASCII)
+	tab.SetHeaders("Name", "Count")
+	tab.AddRow("alpha", 1)
+	tab.SetSummary(SummaryRows)
+	if err := tab.SetOutputName("test-tsv"); err != nil {
+		t.Fatalf("SetOutputName failed: %v", err)
+	}
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	if sb.String() != "Name\tCount\nalpha\t1\n" {
+		t.Errorf("TestRenderer: got %q", sb.String())
+	}
+
+	if err := tab.SetOutputName("csv"); err != nil {
+		t.Fatalf("SetOutputName failed: %v", err)
+	}
+	if tab.Style() != CSV {
+		t.Errorf("SetOutputName selected style %s", tab.Style())
+	}
+	if err := tab.SetOutputName("unknown"); err == nil {
+		t.Errorf("SetOutputName accepted unknown name")
+	}
+}
+
+func TestRendererError(t *testing.T) {
+	renderErr := errors.New("render failed")
+
+	tab := New(Plain)
+	tab.Header("Name")
+	tab.SetRenderer(RendererFunc(func(t *Tabulate, w io.Writer) error {
+		return renderErr
+	}))
+	tab.SetLineFilter(strings.ToUpper)
+
+	_, err := tab.Fprint(io.Discard)
+	if err != renderErr {
+		t.Errorf("Fprint returned %v, expected %v", err, renderErr)
+	}
+}
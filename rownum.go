@@ -0,0 +1,72 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+// SetRowNumbers enables the row index column. The column is prepended
+// to the table at render time and it numbers the data rows starting
+// from start. The header is the label of the column header. The group
+// header rows are not numbered. The row numbers are not rendered in
+// the machine-readable output formats so they do not affect the CSV
+// columns or the JSON keys. The negative start disables the row
+// numbers.
+func (t *Tabulate) SetRowNumbers(start int, header string) {
+	t.rowNumbers = start >= 0
+	t.rowStart = start
+	t.rowHeader = header
+	t.asData = nil
+}
+
+// numbered creates a new tabulator which has the row index column
+// followed by the columns of this tabulator. The headers and cells
+// are shared with this tabulator.
+func (t *Tabulate) numbered() *Tabulate {
+	view := t.derive()
+	view.rowNumbers = false
+	rows := view.Rows
+	view.Rows = nil
+
+	if len(t.Headers) > 0 {
+		hdr := &Column{
+			Data: NewLines(t.rowHeader),
+		}
+		hdr.SetAlign(TR)
+		view.Headers = append([]*Column{hdr}, t.Headers...)
+	}
+	if len(t.headerGroups) > 0 {
+		view.headerGroups = append([]*Column{{
+			Data: NewLinesData(nil),
+			Span: 1,
+		}}, t.headerGroups...)
+	}
+	if t.split {
+		view.split = true
+		view.frozen = []int{0}
+		for _, idx := range t.frozen {
+			view.frozen = append(view.frozen, idx+1)
+		}
+	}
+
+	n := t.rowStart
+	for _, row := range rows {
+		r := &Row{
+			Tab:    view,
+			group:  row.group,
+			footer: row.footer,
+		}
+		col := &Column{
+			Data: NewLinesData(nil),
+		}
+		if !row.group && !row.footer {
+			col.Data = NewValue(n)
+			n++
+		}
+		col.SetAlign(TR)
+		r.Columns = append([]*Column{col}, row.Columns...)
+		view.Rows = append(view.Rows, r)
+	}
+	return view
+}
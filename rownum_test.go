@@ -0,0 +1,75 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestRowNumbers(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.Header("Value")
+	tab.Group("Group")
+	tab.AddRow("alpha", "a")
+	tab.AddRow("beta", "b")
+	tab.SetRowNumbers(1, "#")
+	tab.HideColumn(1)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +---+-------+
+        | # | Name  |
+        +---+-------+
+        |   | Group |
+        | 1 | alpha |
+        | 2 | beta  |
+        +---+-------+
+`, "TestRowNumbers")
+
+	match(t, tab.Render(CSV), `
+        Name
+        alpha
+        beta
+`, "TestRowNumbers CSV")
+
+	tab.SetRowNumbers(-1, "")
+	tab.ShowColumn(1)
+	sb.Reset()
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +-------+-------+
+        | Name  | Value |
+        +-------+-------+
+        | Group         |
+        | alpha | a     |
+        | beta  | b     |
+        +-------+-------+
+`, "TestRowNumbers disabled")
+}
+
+func TestRowNumbersCompact(t *testing.T) {
+	tab := New(ASCII)
+	tab.SetCompact(true)
+	tab.SetHeaders("Name", "Value")
+	tab.AddRow("alpha", 1)
+	tab.AddStrings("beta", "2")
+	tab.AddStrings("gamma", "3")
+	tab.SetRowNumbers(1, "#")
+
+	match(t, tab.String(), `
+        +---+-------+-------+
+        | # | Name  | Value |
+        +---+-------+-------+
+        | 1 | alpha | 1     |
+        | 2 | beta  | 2     |
+        | 3 | gamma | 3     |
+        +---+-------+-------+
+`, "TestRowNumbersCompact")
+}
@@ -0,0 +1,157 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+)
+
+// SetRTL sets the right-to-left layout. In the right-to-left layout,
+// the columns are rendered in the reverse order, the left and right
+// alignments are swapped, and the left and right border elements
+// change places. The layout is not applied to the machine-readable
+// output formats.
+func (t *Tabulate) SetRTL(rtl bool) {
+	t.rtl = rtl
+	t.asData = nil
+}
+
+// mirror creates a new tabulator which has the columns of this
+// tabulator in the reverse order. The headers and cells are shared
+// with this tabulator.
+func (t *Tabulate) mirror() *Tabulate {
+	view := t.derive()
+	view.rtl = false
+	view.mirrored = true
+	view.Borders = Borders{
+		Header: mirrorBorder(t.Borders.Header),
+		Body:   mirrorBorder(t.Borders.Body),
+	}
+
+	n := view.numColumns()
+	rows := view.Rows
+	view.Rows = nil
+	empty := func() *Column {
+		return &Column{
+			Data: NewLinesData(nil),
+		}
+	}
+
+	view.Headers = nil
+	if len(t.Headers) > 0 {
+		for idx := n - 1; idx >= 0; idx-- {
+			if idx < len(t.Headers) {
+				view.Headers = append(view.Headers, t.Headers[idx])
+			} else {
+				view.Headers = append(view.Headers, empty())
+			}
+		}
+	}
+	if len(t.headerGroups) > 0 {
+		var span int
+		for _, group := range t.headerGroups {
+			span += group.span()
+		}
+		view.headerGroups = nil
+		if span < n {
+			view.headerGroups = append(view.headerGroups, &Column{
+				Data: NewLinesData(nil),
+				Span: n - span,
+			})
+		}
+		for idx := len(t.headerGroups) - 1; idx >= 0; idx-- {
+			view.headerGroups = append(view.headerGroups, t.headerGroups[idx])
+		}
+	}
+	if t.split {
+		view.split = true
+		view.frozen = nil
+		for _, idx := range t.frozen {
+			view.frozen = append(view.frozen, n-1-idx)
+		}
+	}
+
+	for _, row := range rows {
+		r := &Row{
+			Tab:    view,
+			group:  row.group,
+			footer: row.footer,
+		}
+		var span int
+		for _, col := range row.Columns {
+			span += col.span()
+		}
+		for ; span < n; span++ {
+			r.Columns = append(r.Columns, empty())
+		}
+		for idx := len(row.Columns) - 1; idx >= 0; idx-- {
+			r.Columns = append(r.Columns, row.Columns[idx])
+		}
+		view.Rows = append(view.Rows, r)
+	}
+	return view
+}
+
+// mirrorBorder returns the border with its left and right elements
+// swapped. The box drawing characters of the elements are mirrored
+// horizontally so that the corners and junctions keep their shapes
+// at their new positions.
+func mirrorBorder(b Border) Border {
+	b.VL, b.VR = b.VR, b.VL
+	b.TL, b.TR = b.TR, b.TL
+	b.ML, b.MR = b.MR, b.ML
+	b.BL, b.BR = b.BR, b.BL
+	for _, e := range []*string{
+		&b.HT, &b.HM, &b.HB, &b.VL, &b.VM, &b.VR, &b.TL, &b.TM, &b.TR,
+		&b.ML, &b.MM, &b.MR, &b.BL, &b.BM, &b.BR, &b.VG, &b.TG, &b.MG,
+		&b.BG,
+	} {
+		*e = boxMirror.Replace(*e)
+	}
+	return b
+}
+
+// boxMirror mirrors the box drawing characters horizontally.
+var boxMirror = strings.NewReplacer(boxMirrorPairs()...)
+
+func boxMirrorPairs() []string {
+	pairs := []string{
+		"┌┐", "┍┑", "┎┒", "┏┓", "└┘", "┕┙", "┖┚", "┗┛",
+		"├┤", "┝┥", "┞┦", "┟┧", "┠┨", "┡┩", "┢┪", "┣┫",
+		"┭┮", "┱┲", "┵┶", "┹┺", "┽┾", "╃╄", "╅╆", "╉╊",
+		"╒╕", "╓╖", "╔╗", "╘╛", "╙╜", "╚╝", "╞╡", "╟╢",
+		"╠╣", "╭╮", "╰╯", "╴╶", "╸╺",
+	}
+	var result []string
+	for _, pair := range pairs {
+		r := []rune(pair)
+		result = append(result, string(r[0]), string(r[1]),
+			string(r[1]), string(r[0]))
+	}
+	return result
+}
+
+// mirrorAlign returns the alignment with its left and right
+// alignments swapped.
+func mirrorAlign(align Align) Align {
+	switch align {
+	case TL:
+		return TR
+	case TR:
+		return TL
+	case ML:
+		return MR
+	case MR:
+		return ML
+	case BL:
+		return BR
+	case BR:
+		return BL
+	default:
+		return align
+	}
+}
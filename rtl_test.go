@@ -0,0 +1,114 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"testing"
+)
+
+func TestRTL(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.Header("Count").SetAlign(TR)
+	tab.Header("Note")
+	tab.AddRow("alpha", 1, "first")
+	tab.AddRow("beta", 22)
+	tab.Row().SpanColumn("span", 2)
+	tab.SetRTL(true)
+
+	match(t, tab.String(), `
+        +-------+-------+-------+
+        |  Note | Count |  Name |
+        +-------+-------+-------+
+        | first | 1     | alpha |
+        |       | 22    |  beta |
+        |       |          span |
+        +-------+-------+-------+
+`, "TestRTL")
+
+	match(t, tab.Render(CSV), `
+        Name,Count,Note
+        alpha,1,first
+        beta,22,
+        span,,
+`, "TestRTL CSV")
+
+	tab.SetRTL(false)
+	match(t, tab.String(), `
+        +-------+-------+-------+
+        | Name  | Count | Note  |
+        +-------+-------+-------+
+        | alpha |     1 | first |
+        | beta  |    22 |       |
+        | span          |       |
+        +-------+-------+-------+
+`, "TestRTL LTR")
+}
+
+func TestRTLBorders(t *testing.T) {
+	b := Border{
+		VL: "<",
+		VR: ">",
+		TL: "1",
+		TR: "2",
+		ML: "3",
+		MR: "4",
+		BL: "5",
+		BR: "6",
+	}
+	m := mirrorBorder(b)
+	if m.VL != ">" || m.VR != "<" || m.TL != "2" || m.TR != "1" ||
+		m.ML != "4" || m.MR != "3" || m.BL != "6" || m.BR != "5" {
+		t.Errorf("mirrorBorder: %+v", m)
+	}
+	if mirrorBorder(m) != b {
+		t.Errorf("mirrorBorder is not an involution")
+	}
+
+	u := mirrorBorder(unicodeHeader)
+	if u != unicodeHeader {
+		t.Errorf("mirrorBorder(unicodeHeader): %+v", u)
+	}
+}
+
+func TestRTLUnicode(t *testing.T) {
+	tab := New(Unicode)
+	tab.HeaderGroup("Group", 2)
+	tab.Header("A")
+	tab.Header("B")
+	tab.Header("C")
+	tab.AddRow("1", "2", "3")
+	tab.SetRTL(true)
+
+	match(t, tab.String(), `
+        ┏━━━┳━━━━━━━┓
+        ┃   ┃ Group ┃
+        ┣━━━╋━━━┳━━━┫
+        ┃ C ┃ B ┃ A ┃
+        ┡━━━╇━━━╇━━━┩
+        │ 3 │ 2 │ 1 │
+        └───┴───┴───┘
+`, "TestRTLUnicode")
+}
+
+func TestRTLCompact(t *testing.T) {
+	tab := New(ASCII)
+	tab.SetCompact(true)
+	tab.SetHeaders("Name", "Note")
+	tab.AddRow("alpha", "first")
+	tab.AddStrings("beta", "second")
+	tab.SetRTL(true)
+
+	match(t, tab.String(), `
+        +--------+-------+
+        |   Note |  Name |
+        +--------+-------+
+        |  first | alpha |
+        | second |  beta |
+        +--------+-------+
+`, "TestRTLCompact")
+}
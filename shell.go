@@ -0,0 +1,100 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"io"
+	"strings"
+)
+
+// outputShell is the Output function of the ShellEval style. Each data
+// row is printed as a key=value line where the key is the first
+// column and the value is the rest of the columns, separated by
+// spaces. The keys are converted into valid shell variable names and
+// the values are quoted so that the output can be evaluated in a
+// POSIX shell.
+func outputShell(t *Tabulate, o io.Writer) {
+	var sb strings.Builder
+	for _, row := range t.Rows {
+		if row.group || len(row.Columns) == 0 {
+			continue
+		}
+		var values []string
+		for idx, col := range row.Columns {
+			if idx == 0 {
+				continue
+			}
+			values = append(values, t.shellText(col))
+		}
+		sb.Reset()
+		sb.WriteString(shellName(t.shellText(row.Columns[0])))
+		sb.WriteByte('=')
+		sb.WriteString(shellQuote(strings.Join(values, " ")))
+		sb.WriteByte('\n')
+		if _, err := io.WriteString(o, sb.String()); err != nil {
+			return
+		}
+	}
+}
+
+// shellText returns the full text of the column.
+func (t *Tabulate) shellText(col *Column) string {
+	col = t.widen(t.fill(col))
+	if col.Data == nil {
+		return ""
+	}
+	return stripANSI(col.Data.String())
+}
+
+// shellName converts the key into a shell variable name. The
+// characters which are not valid in variable names are replaced with
+// underscores and the names starting with a digit are prefixed with an
+// underscore.
+func shellName(key string) string {
+	var sb strings.Builder
+	for _, r := range key {
+		if r == '_' || (r >= 'a' && r <= 'z') || (r >= 'A' && r <= 'Z') ||
+			(r >= '0' && r <= '9') {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteByte('_')
+		}
+	}
+	name := sb.String()
+	if len(name) == 0 || (name[0] >= '0' && name[0] <= '9') {
+		name = "_" + name
+	}
+	return name
+}
+
+// shellQuote quotes the value for the POSIX shell. The values which
+// contain only safe characters are returned as-is. All other values
+// are enclosed in single quotes.
+func shellQuote(val string) string {
+	if len(val) == 0 {
+		return "''"
+	}
+	safe := true
+	for _, r := range val {
+		if !shellSafe(r) {
+			safe = false
+			break
+		}
+	}
+	if safe {
+		return val
+	}
+	return "'" + strings.ReplaceAll(val, "'", `'\''`) + "'"
+}
+
+func shellSafe(r rune) bool {
+	switch {
+	case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+		return true
+	}
+	return strings.ContainsRune("_@%+=:,./-", r)
+}
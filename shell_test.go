@@ -0,0 +1,54 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestShellEval(t *testing.T) {
+	tab := New(ShellEval)
+	tab.Header("Key")
+	tab.Header("Value")
+	tab.AddRow("name", "alpha")
+	tab.AddRow("user-id", "it's $HOME")
+	tab.AddRow("2nd", "")
+	tab.AddRow("path", "/usr/bin", "extra")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `name=alpha
+user_id='it'\''s $HOME'
+_2nd=''
+path='/usr/bin extra'
+`
+	if sb.String() != expected {
+		t.Errorf("TestShellEval: got\n%s\nexpected\n%s", sb.String(), expected)
+	}
+}
+
+func TestShellQuote(t *testing.T) {
+	tests := []struct {
+		val      string
+		expected string
+	}{
+		{"", "''"},
+		{"abc", "abc"},
+		{"a b", "'a b'"},
+		{"a;b", "'a;b'"},
+		{"`cmd`", "'`cmd`'"},
+		{"'", `''\'''`},
+		{"line\nbreak", "'line\nbreak'"},
+	}
+	for _, test := range tests {
+		if got := shellQuote(test.val); got != test.expected {
+			t.Errorf("shellQuote(%q) = %q, expected %q",
+				test.val, got, test.expected)
+		}
+	}
+}
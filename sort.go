@@ -0,0 +1,43 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"sort"
+)
+
+// Less reports whether the data a sorts before the data b.
+type Less func(a, b Data) bool
+
+// LessData compares the data values line by line.
+func LessData(a, b Data) bool {
+	return compareData(a, b) < 0
+}
+
+// Sort sorts the table rows by the values of the column idx. The rows
+// are compared with the column header's Less function. If the column
+// does not have header or its Less function is unset, the rows are
+// compared with LessData. The sort is stable and the rows which do
+// not have the column idx sort first.
+func (t *Tabulate) Sort(idx int) {
+	less := LessData
+	if idx < len(t.Headers) && t.Headers[idx].Less != nil {
+		less = t.Headers[idx].Less
+	}
+	sort.SliceStable(t.Rows, func(i, j int) bool {
+		a := t.Rows[i].cell(idx)
+		b := t.Rows[j].cell(idx)
+		if a == nil || a.Data == nil {
+			return b != nil && b.Data != nil
+		}
+		if b == nil || b.Data == nil {
+			return false
+		}
+		return less(a.Data, b.Data)
+	})
+	t.asData = nil
+}
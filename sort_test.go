@@ -0,0 +1,76 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func TestSort(t *testing.T) {
+	tab := tabulate(New(ASCII), TL, `Name,Priority
+a,low
+b,high
+c,medium
+d,high`)
+
+	priorities := map[string]int{
+		"high":   0,
+		"medium": 1,
+		"low":    2,
+	}
+	tab.Headers[1].SetLess(func(a, b Data) bool {
+		return priorities[a.String()] < priorities[b.String()]
+	})
+
+	tab.Sort(1)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +------+----------+
+        | Name | Priority |
+        +------+----------+
+        | b    | high     |
+        | d    | high     |
+        | c    | medium   |
+        | a    | low      |
+        +------+----------+
+`
+	match(t, sb.String(), expected, "TestSort")
+
+	tab.Sort(0)
+	var names []string
+	for _, row := range tab.Rows {
+		names = append(names, row.Columns[0].Data.String())
+	}
+	if strings.Join(names, ",") != "a,b,c,d" {
+		t.Errorf("TestSort default: got %v", names)
+	}
+}
+
+func TestSortNumeric(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("N").SetLess(func(a, b Data) bool {
+		ai, _ := strconv.Atoi(a.String())
+		bi, _ := strconv.Atoi(b.String())
+		return ai < bi
+	})
+	for _, v := range []string{"10", "9", "100"} {
+		tab.Row().Column(v)
+	}
+	tab.Sort(0)
+
+	var values []string
+	for _, row := range tab.Rows {
+		values = append(values, row.Columns[0].Data.String())
+	}
+	if strings.Join(values, ",") != "9,10,100" {
+		t.Errorf("TestSortNumeric: got %v", values)
+	}
+}
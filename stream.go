@@ -0,0 +1,199 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"io"
+)
+
+// Stream renders table rows as they are added. The column widths are
+// locked when the stream has buffered lockAfter rows or when the
+// stream is closed. After that, the rows are printed and flushed to
+// the writer as they are added and they are not retained in the
+// table. The cells which do not fit into the locked widths are
+// truncated. The column widths can be declared with the header
+// columns' SetWidth. The Stream does not support the table Output
+// functions and renderers, and it is not safe for concurrent use.
+type Stream struct {
+	t         *Tabulate
+	cw        *countWriter
+	lw        *lineWriter
+	bw        *bufferedWriter
+	lockAfter int
+	layout    *streamLayout
+}
+
+// Stream creates a streaming renderer for the table. The table
+// headers must be defined before the stream is created. If lockAfter
+// is zero, the column widths are locked immediately from the
+// headers.
+func (t *Tabulate) Stream(o io.Writer, lockAfter int) *Stream {
+	s := &Stream{
+		t: t,
+		cw: &countWriter{
+			w: o,
+		},
+		lockAfter: lockAfter,
+	}
+	var w io.Writer = s.cw
+	if t.lineFilter != nil {
+		s.lw = &lineWriter{
+			w:      w,
+			filter: t.lineFilter,
+		}
+		w = s.lw
+	}
+	s.bw = &bufferedWriter{
+		w: w,
+	}
+	if lockAfter <= 0 {
+		s.lock()
+	}
+	return s
+}
+
+// AddRow adds a new data row with the argument values. The values are
+// converted to columns like in Tabulate.AddRow. The function returns
+// the first write error of the stream.
+func (s *Stream) AddRow(values ...interface{}) error {
+	s.t.AddRow(values...)
+	if s.layout == nil {
+		if len(s.t.Rows) < s.lockAfter {
+			return nil
+		}
+		s.lock()
+	}
+	s.flushRows()
+	return s.cw.err
+}
+
+// Flush writes all printed rows to the underlying writer.
+func (s *Stream) Flush() error {
+	s.bw.Flush()
+	return s.cw.err
+}
+
+// Close prints the buffered rows and the table bottom border, and
+// flushes the stream. The function returns the first write error of
+// the stream.
+func (s *Stream) Close() error {
+	if s.layout == nil {
+		s.lock()
+		s.flushRows()
+	}
+	l := s.layout
+	if l.numRows > 0 {
+		l.view.printBottom(s.bw, l.view.Borders.Body, l.widths)
+	} else if len(l.headers) > 0 {
+		l.view.printBottom(s.bw, l.view.Borders.Header, l.widths)
+	}
+	s.bw.Flush()
+	if s.lw != nil {
+		s.lw.Flush()
+	}
+	return s.cw.err
+}
+
+// lock locks the column widths and prints and flushes the table
+// header.
+func (s *Stream) lock() {
+	s.layout = s.t.lockLayout(s.bw)
+	s.bw.Flush()
+}
+
+// flushRows prints and flushes the buffered rows and removes them from
+// the table.
+func (s *Stream) flushRows() {
+	t := s.t
+	t.mu.Lock()
+	rows := t.Rows
+	t.Rows = nil
+	t.mu.Unlock()
+
+	s.layout.printRows(s.bw, rows)
+	s.bw.Flush()
+}
+
+// Flush prints the data rows added since the previous Flush. The
+// first Flush locks the column widths from the current rows and
+// prints the table header. The rows which do not fit into the locked
+// widths are truncated. Unlike Stream, the flushed rows are kept in
+// the table; the rows must only be appended between the flushes. The
+// table bottom border is not printed. The function returns the first
+// write error.
+func (t *Tabulate) Flush(o io.Writer) error {
+	cw := &countWriter{
+		w: o,
+	}
+	var w io.Writer = cw
+	var lw *lineWriter
+	if t.lineFilter != nil {
+		lw = &lineWriter{
+			w:      w,
+			filter: t.lineFilter,
+		}
+		w = lw
+	}
+	bw := &bufferedWriter{
+		w: w,
+	}
+
+	rows, _ := t.snapshot()
+	if t.flush == nil {
+		t.flush = t.lockLayout(bw)
+	}
+	if t.flush.numRows < len(rows) {
+		t.flush.printRows(bw, rows[t.flush.numRows:])
+	}
+	bw.Flush()
+	if lw != nil {
+		lw.Flush()
+	}
+	return cw.err
+}
+
+// streamLayout holds the locked layout of the Stream and the
+// incremental Flush. The rows are rendered with a derived view of the
+// table which holds the rendering state of the layout.
+type streamLayout struct {
+	view    *Tabulate
+	headers []*Column
+	widths  []int
+	numRows int
+	prev    *Row
+}
+
+// lockLayout locks the column widths from the current rows of the
+// table and prints the table header.
+func (t *Tabulate) lockLayout(o io.Writer) *streamLayout {
+	view := t.derive()
+	l := &streamLayout{
+		view:    view,
+		headers: view.Headers,
+	}
+	view.units = view.measureUnits(l.headers)
+	view.numeric = view.numericColumns()
+	l.widths = view.measure(l.headers)
+	if view.MaxWidth > 0 {
+		l.headers, l.widths = view.abbreviate(l.headers, l.widths)
+		view.distribute(l.headers, l.widths)
+	}
+	view.printHeader(o, l.headers, l.widths)
+	return l
+}
+
+// printRows prints the rows with the locked layout.
+func (l *streamLayout) printRows(o io.Writer, rows []*Row) {
+	for _, row := range rows {
+		if l.numRows == 0 {
+			l.view.printBodyTop(o, l.headers, l.widths)
+		}
+		l.view.printRow(o, l.headers, l.widths, row, l.prev)
+		l.prev = row
+		l.numRows++
+	}
+}
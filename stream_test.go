@@ -0,0 +1,132 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestStream(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.Header("Count").SetAlign(MR)
+
+	var sb strings.Builder
+	stream := tab.Stream(&sb, 2)
+	for _, name := range []string{"alpha", "beta", "gamma-delta"} {
+		if err := stream.AddRow(name, len(name)); err != nil {
+			t.Fatalf("AddRow failed: %v", err)
+		}
+	}
+	match(t, sb.String(), `
+        +-------+-------+
+        | Name  | Count |
+        +-------+-------+
+        | alpha |     5 |
+        | beta  |     4 |
+        | gamma |    11 |
+`, "TestStream AddRow")
+	if len(tab.Rows) != 0 {
+		t.Errorf("TestStream: table retains %d rows", len(tab.Rows))
+	}
+
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	match(t, sb.String(), `
+        +-------+-------+
+        | Name  | Count |
+        +-------+-------+
+        | alpha |     5 |
+        | beta  |     4 |
+        | gamma |    11 |
+        +-------+-------+
+`, "TestStream")
+}
+
+func TestStreamFixedWidth(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name").SetWidth(8)
+
+	var sb strings.Builder
+	stream := tab.Stream(&sb, 0)
+	stream.AddRow("alpha")
+	stream.AddRow("a-very-long-name")
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	match(t, sb.String(), `
+        +----------+
+        | Name     |
+        +----------+
+        | alpha    |
+        | a-very-l |
+        +----------+
+`, "TestStreamFixedWidth")
+
+	sb.Reset()
+	stream = tab.Stream(&sb, 10)
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	match(t, sb.String(), `
+        +----------+
+        | Name     |
+        +----------+
+`, "TestStreamFixedWidth empty")
+}
+
+func TestFlush(t *testing.T) {
+	tab := New(Simple)
+	tab.Header("Time")
+	tab.Header("Event")
+	tab.AddRow("10:00", "start")
+
+	var sb strings.Builder
+	if err := tab.Flush(&sb); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	if err := tab.Flush(&sb); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	tab.AddRow("10:01", "checkpoint")
+	tab.AddRow("10:02", "stop")
+	if err := tab.Flush(&sb); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	match(t, sb.String(), `
+        Time  Event
+        ----- -----
+        10:00 start
+        10:01 check
+        10:02 stop
+`, "TestFlush")
+	if len(tab.Rows) != 3 {
+		t.Errorf("TestFlush: table has %d rows, expected 3", len(tab.Rows))
+	}
+}
+
+func TestStreamRenderState(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.Header("Size").SetUnitAlign(true)
+	tab.AddRow("a", "1 KiB")
+
+	var sb strings.Builder
+	if err := tab.Flush(&sb); err != nil {
+		t.Fatalf("Flush failed: %v", err)
+	}
+	stream := tab.Stream(&sb, 0)
+	stream.AddRow("b", "12 MiB")
+	if err := stream.Close(); err != nil {
+		t.Fatalf("Close failed: %v", err)
+	}
+	if tab.units != nil || tab.numeric != nil {
+		t.Errorf("TestStreamRenderState: render state stored on the table")
+	}
+}
@@ -0,0 +1,78 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ = Data((&ColorSwatch{}))
+)
+
+// ColorSwatch implements the Data interface for colors. The color is
+// rendered as a colored block, using 24-bit background color escape
+// codes, followed by its hex code.
+type ColorSwatch struct {
+	hex     string
+	content string
+}
+
+// NewColorSwatch creates a new ColorSwatch for the hex color code in
+// the "#rrggbb" or "#rgb" format. If the color code is invalid, only
+// the code is rendered.
+func NewColorSwatch(hex string) *ColorSwatch {
+	swatch := &ColorSwatch{
+		hex:     hex,
+		content: hex,
+	}
+	r, g, b, ok := parseHexColor(hex)
+	if ok {
+		swatch.content = fmt.Sprintf("\x1b[48;2;%d;%d;%dm  \x1b[m %s",
+			r, g, b, hex)
+	}
+	return swatch
+}
+
+func parseHexColor(hex string) (r, g, b uint8, ok bool) {
+	s := strings.TrimPrefix(hex, "#")
+	if len(s) == 3 {
+		s = string([]byte{s[0], s[0], s[1], s[1], s[2], s[2]})
+	}
+	if len(s) != 6 {
+		return
+	}
+	v, err := strconv.ParseUint(s, 16, 32)
+	if err != nil {
+		return
+	}
+	return uint8(v >> 16), uint8(v >> 8), uint8(v), true
+}
+
+// Width implements the Data.Width().
+func (swatch *ColorSwatch) Width(m Measure) int {
+	return m(swatch.content)
+}
+
+// Height implements the Data.Height().
+func (swatch *ColorSwatch) Height() int {
+	return 1
+}
+
+// Content implements the Data.Content().
+func (swatch *ColorSwatch) Content(row int) string {
+	if row > 0 {
+		return ""
+	}
+	return swatch.content
+}
+
+func (swatch *ColorSwatch) String() string {
+	return swatch.hex
+}
@@ -0,0 +1,43 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestColorSwatch(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.Header("Color")
+
+	row := tab.Row()
+	row.Column("primary")
+	row.ColumnData(NewColorSwatch("#ff8000"))
+
+	row = tab.Row()
+	row.Column("accent")
+	row.ColumnData(NewColorSwatch("#0f0"))
+
+	row = tab.Row()
+	row.Column("bad")
+	row.ColumnData(NewColorSwatch("blue"))
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +---------+------------+
+        | Name    | Color      |
+        +---------+------------+
+        | primary | ` + "\x1b[48;2;255;128;0m  \x1b[m" + ` #ff8000 |
+        | accent  | ` + "\x1b[48;2;0;255;0m  \x1b[m" + ` #0f0    |
+        | bad     | blue       |
+        +---------+------------+
+`
+	match(t, sb.String(), expected, "TestColorSwatch")
+}
@@ -7,11 +7,12 @@
 package tabulate
 
 import (
-	"encoding/json"
 	"fmt"
 	"io"
 	"sort"
 	"strings"
+	"sync"
+	"unicode/utf8"
 
 	"golang.org/x/text/width"
 )
@@ -57,6 +58,29 @@ func (a Align) String() string {
 	return fmt.Sprintf("{align %d}", a)
 }
 
+// ParseAlign parses the alignment name. The names are the alignment
+// constant names, such as "TL" and "MR", and they are matched case
+// insensitively.
+func ParseAlign(name string) (Align, error) {
+	for align, n := range aligns {
+		if strings.EqualFold(n, name) {
+			return align, nil
+		}
+	}
+	return None, fmt.Errorf("unknown alignment: %s", name)
+}
+
+// Set implements the flag.Value.Set() for alignment command line
+// flags.
+func (a *Align) Set(value string) error {
+	align, err := ParseAlign(value)
+	if err != nil {
+		return err
+	}
+	*a = align
+	return nil
+}
+
 // Style specifies the table borders and rendering style.
 type Style int
 
@@ -77,6 +101,9 @@ const (
 	Github
 	CSV
 	JSON
+	ShellEval
+	HTML
+	Env
 )
 
 // Styles list all supported tabulation types.
@@ -96,6 +123,9 @@ var Styles = map[string]Style{
 	"github":         Github,
 	"csv":            CSV,
 	"json":           JSON,
+	"shell":          ShellEval,
+	"html":           HTML,
+	"env":            Env,
 }
 
 func (s Style) String() string {
@@ -107,6 +137,36 @@ func (s Style) String() string {
 	return fmt.Sprintf("{Style %d}", s)
 }
 
+// ParseStyle parses the tabulation style name. The names are the
+// keys of the Styles map and they are matched case insensitively.
+func ParseStyle(name string) (Style, error) {
+	style, ok := Styles[strings.ToLower(name)]
+	if !ok {
+		return Plain, fmt.Errorf("unknown style: %s", name)
+	}
+	return style, nil
+}
+
+// Set implements the flag.Value.Set() for style command line flags.
+func (s *Style) Set(value string) error {
+	style, err := ParseStyle(value)
+	if err != nil {
+		return err
+	}
+	*s = style
+	return nil
+}
+
+// AllStyles returns all tabulation styles in their numeric order.
+func AllStyles() []Style {
+	var result []Style
+	for _, style := range Styles {
+		result = append(result, style)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
 // StyleNames returns the tabulation style names as a sorted slice.
 func StyleNames() []string {
 	var names []string
@@ -117,7 +177,13 @@ func StyleNames() []string {
 	return names
 }
 
-// Border specifies the table border drawing elements.
+// Border specifies the table border drawing elements. The VG, TG, MG,
+// and BG elements are the vertical separator and its top, middle, and
+// bottom junctions between column groups. If they are unset, the
+// column groups are separated with the normal vertical borders. The
+// IL, IM, and IR elements are the left, middle, and right junctions
+// of the line between the header group row and the header row. If
+// they are unset, the ML, MM, and MR elements are used.
 type Border struct {
 	HT string
 	HM string
@@ -134,6 +200,13 @@ type Border struct {
 	BL string
 	BM string
 	BR string
+	VG string
+	TG string
+	MG string
+	BG string
+	IL string
+	IM string
+	IR string
 }
 
 // Borders specifies the thable border drawing elements for the table
@@ -177,6 +250,13 @@ var unicodeHeader = Border{
 	BL: "\u2517",
 	BM: "\u253B",
 	BR: "\u251B",
+	VG: "\u2503",
+	TG: "\u2533",
+	MG: "\u254B",
+	BG: "\u253B",
+	IL: "\u2523",
+	IM: "\u254B",
+	IR: "\u252B",
 }
 
 var unicodeBody = Border{
@@ -195,6 +275,10 @@ var unicodeBody = Border{
 	BL: "\u2514",
 	BM: "\u2534",
 	BR: "\u2518",
+	VG: "\u2503",
+	TG: "\u2530",
+	MG: "\u2542",
+	BG: "\u2538",
 }
 
 var unicodeLight = Border{
@@ -213,6 +297,10 @@ var unicodeLight = Border{
 	BL: "\u2514",
 	BM: "\u2534",
 	BR: "\u2518",
+	VG: "\u2503",
+	TG: "\u2530",
+	MG: "\u2542",
+	BG: "\u2538",
 }
 
 var unicodeBold = Border{
@@ -330,21 +418,77 @@ var borders = map[Style]Borders{
 			VR: "\r",
 		},
 	},
-	JSON: {},
+	JSON:      {},
+	ShellEval: {},
+	HTML:      {},
+	Env:       {},
 }
 
-// Tabulate defined a tabulator instance.
+// Tabulate defined a tabulator instance. If Vertical is set, the
+// table is printed in the record view where each row is rendered as a
+// block of header and value rows. If Wide is set, the cells which
+// have shortened representations, such as IDs, are rendered in full.
+// The TabWidth specifies the tab stop interval for expanding tab
+// characters inside cells; zero disables the tab expansion.
 type Tabulate struct {
-	Padding     int
-	TrimColumns bool
-	Borders     Borders
-	Measure     Measure
-	Escape      Escape
-	Output      func(t *Tabulate, o io.Writer)
-	Defaults    []Align
-	Headers     []*Column
-	Rows        []*Row
-	asData      Data
+	Padding       int
+	TrimColumns   bool
+	MaxWidth      int
+	NoColors      bool
+	Deterministic bool
+	Vertical      bool
+	Wide          bool
+	TabWidth      int
+	Borders       Borders
+	Measure       Measure
+	Escape        Escape
+	Output        func(t *Tabulate, o io.Writer)
+	Defaults      []Align
+	Headers       []*Column
+	Rows          []*Row
+	style         Style
+	asData        Data
+	revision      int
+	units         []*units
+	numeric       []bool
+	hidden        map[int]bool
+	order         []int
+	split         bool
+	frozen        []int
+	summary       func(t *Tabulate) string
+	lineFilter    LineFilter
+	maxRows       int
+	autoAlign     bool
+	placeholder   string
+	fallbacks     []Style
+	fixedStyle    bool
+	lineBreak     string
+	detector      CharsetDetector
+	reflectOpts   ReflectOpts
+	depth         int
+	highlights    []highlight
+	groupSep      bool
+	headerGroups  []*Column
+	renderer      Renderer
+	compact       bool
+	parallel      int
+	flush         *streamLayout
+	jsonMode      JSONMode
+	jsonInfer     bool
+	jsonKey       int
+	jsonPrefix    string
+	jsonIndent    string
+	colorMode     ColorMode
+	rowNumbers    bool
+	rowStart      int
+	rowHeader     string
+	totals        []int
+	separator     string
+	emptyText     string
+	rtl           bool
+	mirrored      bool
+	compactRows   [][]string
+	mu            sync.Mutex
 }
 
 // Measure returns the column width in display units. This can be used
@@ -353,17 +497,18 @@ type Measure func(column string) int
 
 // MeasureRunes measures the column width by counting its runes. This
 // assumes that all runes have the same width consuming single output
-// column cell.
+// column cell. The ANSI escape sequences are not counted.
 func MeasureRunes(column string) int {
-	return len([]rune(column))
+	return utf8.RuneCountInString(stripANSI(column))
 }
 
 // MeasureUnicode measures the column width by taking into
 // consideration East Asian Wide characters. The function assumes that
-// East Asian Wide characters consume two output column cells.
+// East Asian Wide characters consume two output column cells. The
+// ANSI escape sequences are not counted.
 func MeasureUnicode(column string) int {
 	var w int
-	for _, r := range column {
+	for _, r := range stripANSI(column) {
 		if width.LookupRune(r).Kind() == width.EastAsianWide {
 			w += 2
 		} else {
@@ -381,55 +526,89 @@ type Escape func(string) string
 // style.
 func New(style Style) *Tabulate {
 	tab := &Tabulate{
-		Padding: 2,
-		Borders: borders[style],
-		Measure: MeasureUnicode,
+		TabWidth: 8,
+		Measure:  defaultMeasure,
+		NoColors: defaultNoColors,
 	}
+	tab.SetStyle(style)
+	return tab
+}
+
+// SetStyle sets the table rendering style. The function sets the
+// table borders, padding, and output functions for the style.
+func (t *Tabulate) SetStyle(style Style) {
+	t.style = style
+	t.Padding = defaultPadding
+	t.TrimColumns = false
+	t.Borders = borders[style]
+	t.Escape = nil
+	t.Output = nil
+	t.renderer = nil
+	t.lineBreak = ""
+
 	switch style {
-	case Colon, Simple, SimpleUnicode, SimpleUnicodeBold,
+	case Colon:
+		t.Padding = 0
+		if len(t.separator) > 0 {
+			t.Borders.Header.VM = t.separator
+			t.Borders.Body.VM = t.separator
+		}
+	case Simple, SimpleUnicode, SimpleUnicodeBold,
 		CompactUnicode, CompactUnicodeLight, CompactUnicodeBold:
-		tab.Padding = 0
+		t.Padding = 0
+	case Github:
+		t.Escape = escapeMarkdown
+		t.lineBreak = "<br>"
 	case CSV:
-		tab.Padding = 0
-		tab.TrimColumns = true
-		tab.Escape = escapeCSV
+		t.Padding = 0
+		t.TrimColumns = true
+		t.Output = outputCSV
 	case JSON:
-		tab.Padding = 0
-		tab.TrimColumns = true
-		tab.Output = outputJSON
+		t.Padding = 0
+		t.TrimColumns = true
+		t.Output = outputJSON
+	case ShellEval:
+		t.Padding = 0
+		t.TrimColumns = true
+		t.Output = outputShell
+	case HTML:
+		t.Padding = 0
+		t.TrimColumns = true
+		t.Output = outputHTML
+	case Env:
+		t.Padding = 0
+		t.TrimColumns = true
+		t.Output = outputEnv
 	}
-	return tab
+	t.asData = nil
 }
 
-func escapeCSV(val string) string {
-	idxQuote := strings.IndexRune(val, '"')
-	idxNewline := strings.IndexRune(val, '\n')
-
-	if idxQuote < 0 && idxNewline < 0 {
-		return val
-	}
-
-	var runes []rune
-	runes = append(runes, '"')
-	for _, r := range []rune(val) {
-		if r == '"' {
-			runes = append(runes, '"')
-		}
-		runes = append(runes, r)
-	}
-	runes = append(runes, '"')
+// Render renders the table with the argument style and returns the
+// rendered table. The table's own style and style attributes are not
+// modified.
+func (t *Tabulate) Render(style Style) string {
+	var sb strings.Builder
+	t.styled(style).Print(&sb)
+	return sb.String()
+}
 
-	return string(runes)
+// Style returns the table rendering style.
+func (t *Tabulate) Style() Style {
+	return t.style
 }
 
-func outputJSON(t *Tabulate, o io.Writer) {
-	data, err := json.Marshal(t)
-	if err != nil {
-		fmt.Fprintf(o, "JSON marshal failed: %s", err)
-		return
-	}
-	fmt.Fprintf(o, string(data))
-	fmt.Fprintln(o)
+// markdownEscaper escapes the Markdown table cell delimiters and the
+// code span backticks, and converts the embedded newlines into line
+// breaks.
+var markdownEscaper = strings.NewReplacer(
+	"|", `\|`,
+	"`", "\\`",
+	"\r\n", "<br>",
+	"\n", "<br>",
+)
+
+func escapeMarkdown(val string) string {
+	return markdownEscaper.Replace(val)
 }
 
 // SetDefaults sets the column default attributes. These are used if
@@ -441,6 +620,32 @@ func (t *Tabulate) SetDefaults(col int, align Align) {
 	t.Defaults[col] = align
 }
 
+// SetDeterministic sets the deterministic mode. In the deterministic
+// mode, the tabulator guarantees stable output for the same input: all
+// orderings are total and no environment-dependent behavior, such as
+// terminal detection, is applied. This is useful for golden-file
+// tests.
+func (t *Tabulate) SetDeterministic(deterministic bool) {
+	t.Deterministic = deterministic
+}
+
+// SetWidthFunc sets the function which measures the display width of
+// the cell contents. The function is used by all data types and the
+// renderers through the Measure field so it can plug in external
+// width tables or font metrics. The ANSI escape sequences are removed
+// from the strings before they are passed to the function. The nil
+// function restores the default measure.
+func (t *Tabulate) SetWidthFunc(fn func(string) int) {
+	if fn == nil {
+		t.Measure = defaultMeasure
+	} else {
+		t.Measure = func(column string) int {
+			return fn(stripANSI(column))
+		}
+	}
+	t.asData = nil
+}
+
 // Header adds a new column to the table and specifies its header
 // label.
 func (t *Tabulate) Header(label string) *Column {
@@ -454,162 +659,1153 @@ func (t *Tabulate) HeaderData(data Data) *Column {
 		Data: data,
 	}
 	t.Headers = append(t.Headers, col)
+	t.asData = nil
+	return col
+}
+
+// HeaderGroup adds a new header group to the table. The header groups
+// are rendered above the header columns and each group spans over
+// span header columns, starting after the columns of the previously
+// added groups. Use an empty label to leave columns without a group.
+func (t *Tabulate) HeaderGroup(label string, span int) *Column {
+	if span < 1 {
+		span = 1
+	}
+	col := &Column{
+		Data: NewLines(label),
+		Span: span,
+	}
+	t.headerGroups = append(t.headerGroups, col)
+	t.asData = nil
 	return col
 }
 
-// Row adds a new data row to the table.
+// groupStart tests if the table column idx starts a new header group.
+func (t *Tabulate) groupStart(idx int) bool {
+	var start int
+	for _, group := range t.headerGroups {
+		if idx < start+group.span() {
+			return idx == start
+		}
+		start += group.span()
+	}
+	return true
+}
+
+// printHeaderGroups prints the header group row and its top and
+// bottom borders.
+func (t *Tabulate) printHeaderGroups(o io.Writer, widths []int) {
+	if len(t.Borders.Header.HT) > 0 {
+		io.WriteString(o, t.Borders.Header.TL)
+		for idx, width := range widths {
+			writeRepeat(o, t.Borders.Header.HT, width+t.Padding)
+			if idx+1 >= len(widths) {
+				writeln(o, t.Borders.Header.TR)
+			} else if t.groupStart(idx + 1) {
+				io.WriteString(o, t.junction(idx+1, t.Borders.Header.TM,
+					t.Borders.Header.TG))
+			} else {
+				io.WriteString(o, t.Borders.Header.HT)
+			}
+		}
+	}
+
+	var height int
+	for _, group := range t.headerGroups {
+		if group.Height() > height {
+			height = group.Height()
+		}
+	}
+	for line := 0; line < height; line++ {
+		var idx int
+		for _, group := range t.headerGroups {
+			if idx >= len(widths) {
+				break
+			}
+			span := group.span()
+			if idx+span > len(widths) {
+				span = len(widths) - idx
+			}
+			t.printColumn(o, true, t.display(group), idx, line,
+				t.spanWidth(widths[idx:idx+span]), height)
+			idx += span
+		}
+		for ; idx < len(widths); idx++ {
+			t.printColumn(o, true, emptyColumn, idx, line, widths[idx], height)
+		}
+		writeln(o, t.Borders.Header.VR)
+	}
+
+	if len(t.Borders.Header.HM) > 0 {
+		border := t.Borders.Header
+		if len(border.IL) > 0 {
+			border.ML = border.IL
+		}
+		if len(border.IM) > 0 {
+			border.MM = border.IM
+		}
+		if len(border.IR) > 0 {
+			border.MR = border.IR
+		}
+		io.WriteString(o, border.ML)
+		for idx, width := range widths {
+			writeRepeat(o, border.HM, width+t.Padding)
+			if idx+1 >= len(widths) {
+				writeln(o, border.MR)
+			} else if t.groupStart(idx + 1) {
+				io.WriteString(o,
+					t.junction(idx+1, border.MM, border.MG))
+			} else {
+				io.WriteString(o,
+					t.junction(idx+1, border.TM, border.TG))
+			}
+		}
+	}
+}
+
+// SetHeaders replaces the table's headers with new columns having the
+// argument labels. The function returns the new header columns.
+func (t *Tabulate) SetHeaders(labels ...string) []*Column {
+	t.Headers = nil
+	for _, label := range labels {
+		t.Header(label)
+	}
+	return t.Headers
+}
+
+// SetAligns sets the alignments of the header columns. The aligns
+// are applied to the headers in order and extra aligns are ignored.
+func (t *Tabulate) SetAligns(aligns ...Align) {
+	for idx, align := range aligns {
+		if idx >= len(t.Headers) {
+			break
+		}
+		t.Headers[idx].SetAlign(align)
+	}
+}
+
+// Row adds a new data row to the table. The function is safe for
+// concurrent use but each returned row must be populated by a single
+// goroutine. The row columns are built before they are added to the
+// row under the table lock so the concurrent renders see the columns
+// added so far but never a partially built column.
 func (t *Tabulate) Row() *Row {
 	row := &Row{
 		Tab: t,
 	}
+	t.appendRow(row)
+	return row
+}
+
+func (t *Tabulate) appendRow(row *Row) {
+	t.mu.Lock()
 	t.Rows = append(t.Rows, row)
+	t.modified()
+	t.mu.Unlock()
+}
+
+// Group starts a new row group. The function adds a group header row
+// with the argument label spanning over all table columns. The data
+// rows added after the group header belong to the group. The table
+// headers must be defined before the groups are started.
+func (t *Tabulate) Group(label string) *Row {
+	row := &Row{
+		Tab:   t,
+		group: true,
+	}
+	span := len(t.Headers)
+	if span < 1 {
+		span = 1
+	}
+	row.SpanColumn(label, span)
+	t.appendRow(row)
+	return row
+}
+
+// SetGroupSeparator sets if the row groups are separated with
+// horizontal lines. The separator is printed before each group header
+// row that does not start the table body.
+func (t *Tabulate) SetGroupSeparator(sep bool) {
+	t.groupSep = sep
+}
+
+// SetSeparator sets the key/value separator of the Colon and Env
+// styles. The Colon style uses the " : " separator and the Env style
+// the "=" separator by default. An empty separator restores the style
+// default.
+func (t *Tabulate) SetSeparator(sep string) {
+	t.separator = sep
+	if t.style == Colon {
+		if len(sep) == 0 {
+			sep = borders[Colon].Body.VM
+		}
+		t.Borders.Header.VM = sep
+		t.Borders.Body.VM = sep
+	}
+	t.asData = nil
+}
+
+// AddRow adds a new data row with the argument values. The string
+// values are added as text columns, Data values as is, nil values as
+// Null data, and all other values with NewValue. The function is safe
+// for concurrent use and the row is added to the table only after all
+// its columns are set.
+func (t *Tabulate) AddRow(values ...interface{}) *Row {
+	row := &Row{
+		Tab: t,
+	}
+	for _, v := range values {
+		switch val := v.(type) {
+		case nil:
+			row.ColumnData(NewNull(nilLabel))
+		case string:
+			row.Column(val)
+		case Data:
+			row.ColumnData(val)
+		default:
+			row.ColumnData(NewValue(val))
+		}
+	}
+	t.appendRow(row)
 	return row
 }
 
-// Print layouts the table into the argument io.Writer.
+// Append appends the data rows of the other table to the table. The
+// optional mapping specifies the table column index for each column
+// of the other table; negative indices drop the columns. Without the
+// mapping, the columns are reconciled by their header labels and the
+// columns which do not have a matching header are added as new
+// columns to the table. If the other table does not have headers, its
+// columns are appended by their positions. The cells are copied with
+// their attributes.
+func (t *Tabulate) Append(other *Tabulate, mapping ...int) {
+	var numCols int
+	for _, row := range other.Rows {
+		var n int
+		for _, col := range row.Columns {
+			n += col.span()
+		}
+		if n > numCols {
+			numCols = n
+		}
+	}
+	if len(other.Headers) > numCols {
+		numCols = len(other.Headers)
+	}
+
+	targets := make([]int, numCols)
+	for idx := range targets {
+		switch {
+		case len(mapping) > 0:
+			if idx < len(mapping) {
+				targets[idx] = mapping[idx]
+			} else {
+				targets[idx] = -1
+			}
+		case idx < len(other.Headers):
+			targets[idx] = t.headerIndex(other.Headers[idx])
+		default:
+			targets[idx] = idx
+		}
+	}
+
+	for _, orow := range other.Rows {
+		var cells []*Column
+		for idx, target := range targets {
+			col := orow.cell(idx)
+			if col == nil || target < 0 {
+				continue
+			}
+			for len(cells) <= target {
+				cells = append(cells, nil)
+			}
+			cells[target] = col
+		}
+		row := &Row{
+			Tab:   t,
+			group: orow.group,
+		}
+		var idx int
+		for target, col := range cells {
+			if col == nil || target < idx {
+				// Missing or covered by a spanning column.
+				continue
+			}
+			for ; idx < target; idx++ {
+				row.Column("")
+			}
+			c := *col
+			row.Columns = append(row.Columns, &c)
+			idx += c.span()
+		}
+		t.appendRow(row)
+	}
+}
+
+// headerIndex returns the index of the header having the same label
+// as hdr. If the table does not have a matching header, the function
+// adds a new header with the hdr data.
+func (t *Tabulate) headerIndex(hdr *Column) int {
+	var label string
+	if hdr.Data != nil {
+		label = hdr.Data.String()
+	}
+	for idx, h := range t.Headers {
+		if h.Data != nil && h.Data.String() == label {
+			return idx
+		}
+	}
+	t.HeaderData(hdr.Data).SetAlign(hdr.Align).SetFormat(hdr.Format).
+		SetNumberFormat(hdr.NumberFormat).SetVerbatim(hdr.Verbatim)
+	return len(t.Headers) - 1
+}
+
+// CellRef identifies a table cell. The Row is the data row index or
+// -1 for the header cells. The Col is the table column where the cell
+// starts.
+type CellRef struct {
+	Row int
+	Col int
+}
+
+// Cells calls the argument function for each header and data cell of
+// the table in the row order. The iteration stops if the function
+// returns false.
+func (t *Tabulate) Cells(fn func(ref CellRef, data Data) bool) {
+	for idx, hdr := range t.Headers {
+		if !fn(CellRef{Row: -1, Col: idx}, hdr.Data) {
+			return
+		}
+	}
+	for r, row := range t.Rows {
+		var idx int
+		for _, col := range row.Columns {
+			if !fn(CellRef{Row: r, Col: idx}, col.Data) {
+				return
+			}
+			idx += col.span()
+		}
+	}
+}
+
+// At returns the column of the data row row starting at the table
+// column col. The function returns nil if the row or column does not
+// exist. The function is safe for concurrent use with RemoveRow.
+func (t *Tabulate) At(row, col int) *Column {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if row < 0 || row >= len(t.Rows) {
+		return nil
+	}
+	return t.Rows[row].cell(col)
+}
+
+// RemoveRow removes the data row i from the table. The function does
+// nothing if the row does not exist.
+func (t *Tabulate) RemoveRow(i int) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if i < 0 || i >= len(t.Rows) {
+		return
+	}
+	t.Rows = append(t.Rows[:i], t.Rows[i+1:]...)
+	t.modified()
+}
+
+// Invalidate clears the cached rendering of the table. The table
+// caches its rendering when it is used as Data, for example in nested
+// tables. The cache is cleared automatically when rows, columns, or
+// headers are added or removed, but the changes made directly to the
+// table fields or to the column attributes require an explicit
+// Invalidate.
+func (t *Tabulate) Invalidate() {
+	t.invalidate()
+}
+
+// invalidate clears the cached rendering of the table. It is safe for
+// concurrent use with the row appends.
+func (t *Tabulate) invalidate() {
+	t.mu.Lock()
+	t.modified()
+	t.mu.Unlock()
+}
+
+// modified clears the cached rendering of the table and advances the
+// table revision. The caller must hold the table lock.
+func (t *Tabulate) modified() {
+	t.asData = nil
+	t.revision++
+}
+
+// Print layouts the table into the argument io.Writer. If the
+// tabulator has a summary function, its result is printed after the
+// table. The summary is not printed for the machine-readable output
+// formats. If the tabulator has a line filter, all printed lines are
+// processed with the filter. The ANSI escape sequences are removed
+// from the output as specified by the color mode.
 func (t *Tabulate) Print(o io.Writer) {
-	if len(t.Headers) == 0 && len(t.Rows) == 0 {
+	if style, ok := t.fallbackStyle(o); ok && style != t.style {
+		t.styled(style).Print(o)
+		return
+	}
+	if t.stripColors(o) {
+		sw := &lineWriter{
+			w:      o,
+			filter: stripANSI,
+		}
+		defer sw.Flush()
+		o = sw
+	}
+	if t.lineFilter != nil {
+		lw := &lineWriter{
+			w:      o,
+			filter: t.lineFilter,
+		}
+		defer lw.Flush()
+		o = lw
+	}
+	if _, ok := o.(*bufferedWriter); !ok {
+		bw := &bufferedWriter{
+			w: o,
+		}
+		defer bw.Flush()
+		o = bw
+	}
+	notes := t.footnotes()
+	// Render a view of the current rows so that the rows added
+	// concurrently don't race with the rendering.
+	rows, compactRows := t.snapshot()
+	numRows := len(rows) + len(compactRows)
+	truncated := t.maxRows > 0 && numRows > t.maxRows
+	if truncated {
+		if len(rows) >= t.maxRows {
+			rows = rows[:t.maxRows]
+			compactRows = nil
+		} else {
+			compactRows = compactRows[:t.maxRows-len(rows)]
+		}
+	}
+	t.deriveRows(rows, compactRows).printAnnotated(o, notes)
+	if truncated && !t.customOutput() && !t.TrimColumns {
+		fmt.Fprintf(o, "... and %s more rows\n",
+			thousands(numRows-t.maxRows))
+	}
+	for idx, note := range notes {
+		fmt.Fprintf(o, "[%d] %s\n", idx+1, note)
+	}
+	if t.summary != nil && !t.customOutput() && !t.TrimColumns {
+		summary := t.summary(t)
+		if len(summary) > 0 {
+			fmt.Fprintln(o, strings.TrimRight(summary, "\n"))
+		}
+	}
+}
+
+// customOutput tests if the table is rendered with an output function
+// or with a renderer.
+func (t *Tabulate) customOutput() bool {
+	return t.Output != nil || t.renderer != nil
+}
+
+// Fprint layouts the table into the argument io.Writer like Print.
+// The function returns the number of bytes written and the first
+// write or renderer error encountered. No output is written after an
+// error.
+func (t *Tabulate) Fprint(w io.Writer) (int, error) {
+	cw := &countWriter{
+		w: w,
+	}
+	t.Print(cw)
+	return cw.n, cw.err
+}
+
+// countWriter implements io.Writer which counts the written bytes and
+// records the first write error.
+type countWriter struct {
+	w   io.Writer
+	n   int
+	err error
+}
+
+func (cw *countWriter) Write(p []byte) (int, error) {
+	if cw.err != nil {
+		return 0, cw.err
+	}
+	n, err := cw.w.Write(p)
+	cw.n += n
+	cw.err = err
+	return n, err
+}
+
+func (cw *countWriter) fail(err error) bool {
+	if cw.err == nil {
+		cw.err = err
+	}
+	return true
+}
+
+// SetMaxRows sets the maximum number of data rows to print. If the
+// table has more rows, only the first maxRows rows are printed,
+// followed by a line telling the number of omitted rows. The zero
+// maxRows prints all rows.
+func (t *Tabulate) SetMaxRows(maxRows int) {
+	t.maxRows = maxRows
+}
+
+// thousands formats the integer with comma thousands separators.
+func thousands(n int) string {
+	str := fmt.Sprintf("%d", n)
+	var prefix string
+	if n < 0 {
+		prefix = "-"
+		str = str[1:]
+	}
+	for i := len(str) - 3; i > 0; i -= 3 {
+		str = str[:i] + "," + str[i:]
+	}
+	return prefix + str
+}
+
+// SetSummary sets the summary function. The function result is
+// printed beneath the table.
+func (t *Tabulate) SetSummary(summary func(t *Tabulate) string) {
+	t.summary = summary
+}
+
+// SummaryRows is a summary function which returns the number of data
+// rows in the table, for example "(3 rows)".
+func SummaryRows(t *Tabulate) string {
+	if t.NumRows() == 1 {
+		return "(1 row)"
+	}
+	return fmt.Sprintf("(%d rows)", t.NumRows())
+}
+
+func (t *Tabulate) print(o io.Writer) {
+	if len(t.Headers) == 0 && t.NumRows() == 0 {
 		// No columns to tabulate.
 		return
 	}
+	if len(t.compactRows) > 0 && !t.compactLayout() {
+		t.derive().print(o)
+		return
+	}
+	if len(t.totals) > 0 && !t.TrimColumns {
+		t.totaled().Print(o)
+		return
+	}
+	if columns := t.visibleColumns(); columns != nil {
+		if len(columns) > 0 {
+			t.view(columns).Print(o)
+		}
+		return
+	}
+	if t.rowNumbers && !t.TrimColumns {
+		t.numbered().Print(o)
+		return
+	}
+	if len(t.emptyText) > 0 && len(t.Headers) > 0 && t.NumRows() == 0 &&
+		!t.TrimColumns && !t.Vertical {
+		t.emptied().Print(o)
+		return
+	}
+	if t.rtl && !t.TrimColumns && !t.Vertical {
+		t.mirror().Print(o)
+		return
+	}
+	if t.renderer != nil {
+		if err := t.renderer.Render(t, o); err != nil {
+			fail(o, err)
+		}
+		return
+	}
 	if t.Output != nil {
 		t.Output(t, o)
 		return
 	}
-	// Measure columns.
-	widths := make([]int, len(t.Headers))
-	for idx, hdr := range t.Headers {
-		w := hdr.Data.Width(t.Measure)
-		if w > widths[idx] {
-			widths[idx] = w
+	if chunks := t.chunks(); chunks != nil {
+		for idx, chunk := range chunks {
+			if idx > 0 {
+				fmt.Fprintln(o)
+			}
+			t.view(chunk).Print(o)
 		}
+		return
 	}
-	for _, row := range t.Rows {
-		for idx, col := range row.Columns {
-			if idx >= len(widths) {
-				widths = append(widths, 0)
-			}
-			w := col.Width(t.Measure)
-			if w > widths[idx] {
-				widths[idx] = w
+	if t.Vertical {
+		t.records().Print(o)
+		return
+	}
+	// Measure columns. The print functions render derived views so
+	// the unit widths and numeric columns are local to the render.
+	headers := t.Headers
+	t.units = t.measureUnits(headers)
+	t.numeric = t.numericColumns()
+	widths := t.measure(headers)
+	if t.MaxWidth > 0 {
+		headers, widths = t.abbreviate(headers, widths)
+		t.distribute(headers, widths)
+	}
+
+	t.printHeader(o, headers, widths)
+
+	var bottomBorder Border
+
+	if t.NumRows() > 0 {
+		t.printBodyTop(o, headers, widths)
+
+		// Data rows.
+		var prev *Row
+		t.eachRow(func(row *Row) {
+			t.printRow(o, headers, widths, row, prev)
+			prev = row
+		})
+		// Use the body graphics to close the table.
+		bottomBorder = t.Borders.Body
+	} else {
+		// No data rows. Use the header graphics to close the table.
+		bottomBorder = t.Borders.Header
+	}
+	t.printBottom(o, bottomBorder, widths)
+}
+
+// printHeader prints the table top border and the header rows.
+func (t *Tabulate) printHeader(o io.Writer, headers []*Column, widths []int) {
+	if len(headers) == 0 {
+		return
+	}
+	if len(t.headerGroups) > 0 && !t.TrimColumns {
+		t.printHeaderGroups(o, widths)
+	} else if len(t.Borders.Header.HT) > 0 {
+		io.WriteString(o, t.Borders.Header.TL)
+		for idx, width := range widths {
+			writeRepeat(o, t.Borders.Header.HT, width+t.Padding)
+			if idx+1 < len(widths) {
+				io.WriteString(o, t.junction(idx+1, t.Borders.Header.TM,
+					t.Borders.Header.TG))
+			} else {
+				writeln(o, t.Borders.Header.TR)
 			}
 		}
 	}
 
-	if len(t.Headers) > 0 {
-		if len(t.Borders.Header.HT) > 0 {
-			fmt.Fprint(o, t.Borders.Header.TL)
+	// The displayed columns can have different heights than the
+	// header columns.
+	displayed := make([]*Column, len(widths))
+	var height int
+	for idx := range widths {
+		hdr := emptyColumn
+		if idx < len(headers) {
+			hdr = headers[idx]
+		}
+		displayed[idx] = t.display(hdr)
+		if displayed[idx].Height() > height {
+			height = displayed[idx].Height()
+		}
+	}
+	for line := 0; line < height; line++ {
+		for idx, width := range widths {
+			t.printColumn(o, true, displayed[idx], idx, line, width,
+				height)
+		}
+		writeln(o, t.Borders.Header.VR)
+	}
+}
+
+// printBodyTop prints the border above the first data row.
+func (t *Tabulate) printBodyTop(o io.Writer, headers []*Column, widths []int) {
+	if len(headers) > 0 {
+		// Both headers and rows.
+		if len(t.Borders.Header.HM) > 0 {
+			io.WriteString(o, t.Borders.Header.ML)
 			for idx, width := range widths {
-				for i := 0; i < width+t.Padding; i++ {
-					fmt.Fprint(o, t.Borders.Header.HT)
-				}
+				writeRepeat(o, t.Borders.Header.HM, width+t.Padding)
 				if idx+1 < len(widths) {
-					fmt.Fprint(o, t.Borders.Header.TM)
+					io.WriteString(o, t.junction(idx+1, t.Borders.Header.MM,
+						t.Borders.Header.MG))
 				} else {
-					fmt.Fprintln(o, t.Borders.Header.TR)
+					writeln(o, t.Borders.Header.MR)
 				}
 			}
 		}
-
-		var height int
-		for _, hdr := range t.Headers {
-			if hdr.Data.Height() > height {
-				height = hdr.Data.Height()
-			}
-		}
-		for line := 0; line < height; line++ {
+	} else {
+		// Only rows.
+		if len(t.Borders.Body.HT) > 0 {
+			io.WriteString(o, t.Borders.Body.TL)
 			for idx, width := range widths {
-				var hdr *Column
-				if idx < len(t.Headers) {
-					hdr = t.Headers[idx]
+				writeRepeat(o, t.Borders.Body.HT, width+t.Padding)
+				if idx+1 < len(widths) {
+					io.WriteString(o, t.junction(idx+1, t.Borders.Body.TM,
+						t.Borders.Body.TG))
 				} else {
-					hdr = &Column{}
+					writeln(o, t.Borders.Body.TR)
 				}
-				t.printColumn(o, true, hdr, idx, line, width, height)
 			}
-			fmt.Fprintln(o, t.Borders.Header.VR)
 		}
 	}
+}
 
-	var bottomBorder Border
+// printRow prints the data row. The prev is the previous data row or
+// nil if the row is the first data row.
+func (t *Tabulate) printRow(o io.Writer, headers []*Column, widths []int,
+	row, prev *Row) {
 
-	if len(t.Rows) > 0 {
-		if len(t.Headers) > 0 {
-			// Both headers and rows.
-			if len(t.Borders.Header.HM) > 0 {
-				fmt.Fprint(o, t.Borders.Header.ML)
-				for idx, width := range widths {
-					for i := 0; i < width+t.Padding; i++ {
-						fmt.Fprint(o, t.Borders.Header.HM)
-					}
-					if idx+1 < len(widths) {
-						fmt.Fprint(o, t.Borders.Header.MM)
-					} else {
-						fmt.Fprintln(o, t.Borders.Header.MR)
-					}
-				}
+	if (row.footer || row.group && t.groupSep) && prev != nil &&
+		len(t.Borders.Header.HM) > 0 {
+		io.WriteString(o, t.Borders.Header.ML)
+		for idx, width := range widths {
+			writeRepeat(o, t.Borders.Header.HM, width+t.Padding)
+			if idx+1 < len(widths) {
+				io.WriteString(o, t.junction(idx+1, t.Borders.Header.MM,
+					t.Borders.Header.MG))
+			} else {
+				writeln(o, t.Borders.Header.MR)
 			}
+		}
+	}
+	// The row height is the height of the displayed columns since
+	// the display form, such as the joined lines of the Github style,
+	// can have a different height than the column data.
+	var displayed []*Column
+	var spans []int
+	var height int
+	var idx int
+	for _, col := range row.Columns {
+		if idx >= len(widths) {
+			break
+		}
+		span := col.span()
+		if idx+span > len(widths) {
+			span = len(widths) - idx
+		}
+		if idx < len(headers) && headers[idx].Merge &&
+			col.equal(prev.cell(idx)) {
+			col = emptyColumn
+		} else {
+			col = t.fill(col)
+		}
+		col = t.display(t.wrap(col, t.spanWidth(widths[idx:idx+span])))
+		if col.Height() > height {
+			height = col.Height()
+		}
+		displayed = append(displayed, col)
+		spans = append(spans, span)
+		idx += span
+	}
+	if height == 0 && len(t.placeholder) > 0 {
+		height = 1
+	}
+
+	for line := 0; line < height; line++ {
+		idx = 0
+		for i, col := range displayed {
+			span := spans[i]
+			t.printColumn(o, false, col, idx, line,
+				t.spanWidth(widths[idx:idx+span]), height)
+			idx += span
+		}
+		for ; idx < len(widths); idx++ {
+			t.printColumn(o, false, t.fill(emptyColumn), idx, line,
+				widths[idx], height)
+		}
+		writeln(o, t.Borders.Body.VR)
+	}
+}
+
+// printBottom prints the table bottom border with the argument border
+// graphics.
+func (t *Tabulate) printBottom(o io.Writer, border Border, widths []int) {
+	if len(border.HB) == 0 {
+		return
+	}
+	io.WriteString(o, border.BL)
+	for idx, width := range widths {
+		writeRepeat(o, border.HB, width+t.Padding)
+		if idx+1 < len(widths) {
+			io.WriteString(o, t.junction(idx+1, border.BM, border.BG))
 		} else {
-			// Only rows.
-			if len(t.Borders.Body.HT) > 0 {
-				fmt.Fprint(o, t.Borders.Body.TL)
-				for idx, width := range widths {
-					for i := 0; i < width+t.Padding; i++ {
-						fmt.Fprint(o, t.Borders.Body.HT)
-					}
-					if idx+1 < len(widths) {
-						fmt.Fprint(o, t.Borders.Body.TM)
-					} else {
-						fmt.Fprintln(o, t.Borders.Body.TR)
-					}
+			writeln(o, border.BR)
+		}
+	}
+}
+
+// measure computes the column widths of the table, using the argument
+// headers as the table header columns.
+func (t *Tabulate) measure(headers []*Column) []int {
+	widths := make([]int, len(headers))
+	for idx, hdr := range headers {
+		w := t.display(hdr).Width(t.Measure)
+		if w > widths[idx] {
+			widths[idx] = w
+		}
+	}
+	if t.parallel > 1 && t.NumRows() >= parallelMinRows {
+		widths = t.measureParallel(widths)
+	} else {
+		t.eachRow(func(row *Row) {
+			widths = t.measureRow(row, widths)
+		})
+	}
+	// Grow the last spanned columns to fit the spanning cells.
+	for _, row := range t.Rows {
+		var idx int
+		for _, col := range row.Columns {
+			span := col.span()
+			if span > 1 {
+				w := t.display(col).Width(t.Measure) -
+					t.spanWidth(widths[idx:idx+span])
+				if w > 0 {
+					widths[idx+span-1] += w
 				}
 			}
+			idx += span
 		}
-
-		// Data rows.
-		for _, row := range t.Rows {
-			height := row.Height()
-
-			for line := 0; line < height; line++ {
-				for idx, width := range widths {
-					var col *Column
-					if idx < len(row.Columns) {
-						col = row.Columns[idx]
-					} else {
-						col = &Column{}
-					}
-					t.printColumn(o, false, col, idx, line, width, height)
+	}
+	// Grow the last grouped columns to fit the header groups.
+	if len(headers) > 0 {
+		var idx int
+		for _, group := range t.headerGroups {
+			if idx >= len(widths) {
+				break
+			}
+			span := group.span()
+			if idx+span > len(widths) {
+				span = len(widths) - idx
+			}
+			w := t.display(group).Width(t.Measure) -
+				t.spanWidth(widths[idx:idx+span])
+			if w > 0 {
+				widths[idx+span-1] += w
+			}
+			idx += span
+		}
+	}
+	// Grow the columns which have missing cells to fit the
+	// placeholder. The empty cells are filled in measureRow.
+	if len(t.placeholder) > 0 {
+		w := t.Measure(t.placeholder)
+		t.eachRow(func(row *Row) {
+			var idx int
+			for _, col := range row.Columns {
+				idx += col.span()
+			}
+			for ; idx < len(widths); idx++ {
+				if w > widths[idx] {
+					widths[idx] = w
 				}
-				fmt.Fprintln(o, t.Borders.Body.VR)
 			}
+		})
+	}
+	for idx, hdr := range headers {
+		if hdr.FixedWidth > 0 {
+			widths[idx] = hdr.FixedWidth
+		} else if hdr.MinWidth > widths[idx] {
+			widths[idx] = hdr.MinWidth
 		}
-		// Use the body graphics to close the table.
-		bottomBorder = t.Borders.Body
-	} else {
-		// No data rows. Use the header graphics to close the table.
-		bottomBorder = t.Borders.Header
 	}
+	return widths
+}
 
-	if len(bottomBorder.HB) > 0 {
-		fmt.Fprint(o, bottomBorder.BL)
-		for idx, width := range widths {
-			for i := 0; i < width+t.Padding; i++ {
-				fmt.Fprint(o, bottomBorder.HB)
+// measureRow grows the widths to fit the non-spanning cells of the
+// row. The function returns the updated widths.
+func (t *Tabulate) measureRow(row *Row, widths []int) []int {
+	var idx int
+	for _, col := range row.Columns {
+		span := col.span()
+		for idx+span > len(widths) {
+			widths = append(widths, 0)
+		}
+		if span == 1 {
+			w := t.display(t.fill(col)).Width(t.Measure)
+			if u := t.unitsAt(idx); u != nil && !col.Verbatim {
+				w = u.width()
 			}
-			if idx+1 < len(widths) {
-				fmt.Fprint(o, bottomBorder.BM)
-			} else {
-				fmt.Fprintln(o, bottomBorder.BR)
+			if w > widths[idx] {
+				widths[idx] = w
 			}
 		}
+		idx += span
+	}
+	return widths
+}
+
+// junction returns the column group separator element g if the
+// column idx starts a new column group and the separator is defined.
+// Otherwise the function returns the element m.
+func (t *Tabulate) junction(idx int, m, g string) string {
+	if len(g) > 0 && idx < len(t.Headers) && t.Headers[idx].Group {
+		return g
+	}
+	return m
+}
+
+// SetPlaceholder sets the placeholder value which is used for missing
+// and empty cells in all output formats.
+func (t *Tabulate) SetPlaceholder(placeholder string) {
+	t.placeholder = placeholder
+}
+
+// fill returns the column with the placeholder data if the column is
+// missing, empty, or null. The null columns are rendered empty in the
+// machine-readable output formats. Otherwise the function returns the
+// column as-is.
+func (t *Tabulate) fill(col *Column) *Column {
+	if _, ok := col.Data.(*Null); ok {
+		if t.TrimColumns {
+			c := *col
+			c.Data = NewNull("")
+			return &c
+		}
+		if len(t.placeholder) == 0 {
+			return col
+		}
+	} else if len(t.placeholder) == 0 || !col.empty() {
+		return col
+	}
+	c := *col
+	c.Data = NewText(t.placeholder)
+	return &c
+}
+
+// wrap returns the column with its error message wrapped at the
+// column width. Other columns are returned as-is.
+func (t *Tabulate) wrap(col *Column, width int) *Column {
+	e, ok := col.Data.(*Error)
+	if !ok {
+		return col
+	}
+	c := *col
+	c.Data = e.wrap(t.Measure, width)
+	return &c
+}
+
+// display returns the column as it is displayed: wide data is
+// applied, the tabs are expanded, and the content is escaped.
+func (t *Tabulate) display(col *Column) *Column {
+	return t.escape(t.expandTabs(t.widen(col)))
+}
+
+// escape returns the column with its content lines escaped with the
+// Escape function. If the style has a line break element, the escaped
+// lines of multi-line columns are joined with it into one line. The
+// escaping is done before the columns are measured so that the escape
+// sequences are included in the column widths. If the column does not
+// change, the function returns the column as-is.
+func (t *Tabulate) escape(col *Column) *Column {
+	if t.Escape == nil || col.Data == nil || col.Verbatim {
+		return col
+	}
+	var lines []string
+	var escaped bool
+	for row := 0; row < col.Data.Height(); row++ {
+		line := col.Data.Content(row)
+		e := t.Escape(line)
+		if e != line {
+			escaped = true
+		}
+		lines = append(lines, e)
+	}
+	if len(t.lineBreak) > 0 && len(lines) > 1 {
+		lines = []string{strings.Join(lines, t.lineBreak)}
+		escaped = true
+	}
+	if !escaped {
+		return col
+	}
+	c := *col
+	if c.Format == FmtNone {
+		if f, ok := col.Data.(formatter); ok {
+			c.Format = f.Format()
+		}
+	}
+	c.Data = NewLinesData(lines)
+	return &c
+}
+
+// expandTabs returns the column with its tab characters expanded to
+// the TabWidth tab stops. The expanded column keeps the format of its
+// data. If the column does not contain tabs, the function returns the
+// column as-is.
+func (t *Tabulate) expandTabs(col *Column) *Column {
+	if t.TabWidth <= 0 || t.TrimColumns || col.Data == nil ||
+		col.Verbatim {
+		return col
+	}
+	var lines []string
+	var expanded bool
+	for row := 0; row < col.Data.Height(); row++ {
+		line := col.Data.Content(row)
+		if strings.IndexByte(line, '\t') >= 0 {
+			line = expandTabs(t.Measure, line, t.TabWidth)
+			expanded = true
+		}
+		lines = append(lines, line)
+	}
+	if !expanded {
+		return col
+	}
+	c := *col
+	if c.Format == FmtNone {
+		if f, ok := col.Data.(formatter); ok {
+			c.Format = f.Format()
+		}
+	}
+	c.Data = NewLinesData(lines)
+	return &c
+}
+
+// expandTabs expands the tab characters of the line to the tab stops
+// at every tabWidth columns.
+func expandTabs(m Measure, line string, tabWidth int) string {
+	var sb strings.Builder
+	var col int
+	for _, r := range line {
+		if r != '\t' {
+			sb.WriteRune(r)
+			col += m(string(r))
+			continue
+		}
+		for {
+			sb.WriteRune(' ')
+			col++
+			if col%tabWidth == 0 {
+				break
+			}
+		}
+	}
+	return sb.String()
+}
+
+// widen returns the column with its wide data if the tabulator is in
+// the wide mode or if it trims the columns for machine-readable
+// output. Otherwise the function returns the column as-is.
+func (t *Tabulate) widen(col *Column) *Column {
+	if !t.Wide && !t.TrimColumns {
+		return col
+	}
+	w, ok := col.Data.(wider)
+	if !ok {
+		return col
+	}
+	c := *col
+	c.Data = w.Wide()
+	return &c
+}
+
+// unitsAt returns the unit alignment widths of the column idx. The
+// function returns nil if the column is not unit aligned.
+func (t *Tabulate) unitsAt(idx int) *units {
+	if idx < len(t.units) {
+		return t.units[idx]
+	}
+	return nil
+}
+
+// spanWidth returns the width of a cell spanning over the columns
+// with the argument widths. The width includes the padding and
+// vertical borders between the columns.
+func (t *Tabulate) spanWidth(widths []int) int {
+	var w int
+	for idx, width := range widths {
+		if idx > 0 {
+			w += t.Padding + t.Measure(t.Borders.Body.VM)
+		}
+		w += width
+	}
+	return w
+}
+
+// tableWidth returns the total width of the table, including padding
+// and borders, for the column widths.
+func (t *Tabulate) tableWidth(widths []int) int {
+	border := t.Borders.Header
+	if len(t.Headers) == 0 {
+		border = t.Borders.Body
+	}
+	w := t.Measure(border.VL) + t.Measure(border.VR)
+	for idx, width := range widths {
+		if idx > 0 {
+			w += t.Measure(border.VM)
+		}
+		w += width + t.Padding
+	}
+	return w
+}
+
+// abbreviate replaces header labels with their abbreviations, from
+// left to right, until the table fits into MaxWidth. The function
+// returns the resulting headers and column widths.
+func (t *Tabulate) abbreviate(headers []*Column, widths []int) (
+	[]*Column, []int) {
+
+	var result []*Column
+	for idx, hdr := range headers {
+		if t.tableWidth(widths) <= t.MaxWidth {
+			break
+		}
+		if len(hdr.Abbrev) == 0 {
+			continue
+		}
+		if result == nil {
+			result = make([]*Column, len(headers))
+			copy(result, headers)
+		}
+		abbrev := *hdr
+		abbrev.Data = NewLines(hdr.Abbrev)
+		result[idx] = &abbrev
+		widths = t.measure(result)
+	}
+	if result == nil {
+		return headers, widths
+	}
+	return result, widths
+}
+
+// distribute adjusts the widths of the weighted columns so that the
+// table width matches MaxWidth. The extra space, or the missing space,
+// is distributed between the columns in proportion to their weights.
+func (t *Tabulate) distribute(headers []*Column, widths []int) {
+	var total int
+	for _, hdr := range headers {
+		if hdr.Weight > 0 && hdr.FixedWidth == 0 && !hdr.Verbatim {
+			total += hdr.Weight
+		}
+	}
+	if total == 0 {
+		return
+	}
+	delta := t.MaxWidth - t.tableWidth(widths)
+	remaining := delta
+	last := -1
+	for idx, hdr := range headers {
+		if hdr.Weight <= 0 || hdr.FixedWidth > 0 || hdr.Verbatim {
+			continue
+		}
+		d := delta * hdr.Weight / total
+		widths[idx] += d
+		remaining -= d
+		last = idx
+	}
+	widths[last] += remaining
+
+	for idx, hdr := range headers {
+		min := hdr.MinWidth
+		if min < 1 {
+			min = 1
+		}
+		if widths[idx] < min {
+			widths[idx] = min
+		}
 	}
 }
 
 func (t *Tabulate) printColumn(o io.Writer, hdr bool, col *Column,
 	idx, line, width, height int) {
 
+	align := t.alignAt(col, idx)
+
 	vspace := height - col.Height()
-	switch col.Align {
+	switch align {
 	case TL, TC, TR, None:
 
 	case ML, MC, MR:
@@ -623,18 +1819,24 @@ func (t *Tabulate) printColumn(o io.Writer, hdr bool, col *Column,
 	if line >= 0 {
 		content = col.Content(line)
 	}
-	if t.Escape != nil {
-		content = t.Escape(content)
+	if u := t.unitsAt(idx); u != nil && !hdr && col.span() == 1 &&
+		!col.Verbatim {
+		content = u.format(t.Measure, content)
 	}
 
 	lPad := t.Padding / 2
 	rPad := t.Padding - lPad
 
-	pad := width - t.Measure(content)
 	if t.TrimColumns {
+		width = 0
+	} else if t.Measure(content) > width && !col.Verbatim {
+		content = truncate(t.Measure, content, width)
+	}
+	pad := width - t.Measure(content)
+	if pad < 0 {
 		pad = 0
 	}
-	switch col.Align {
+	switch align {
 	case None:
 		lPad = 0
 		rPad = 0
@@ -654,39 +1856,77 @@ func (t *Tabulate) printColumn(o io.Writer, hdr bool, col *Column,
 
 	if hdr {
 		if idx == 0 {
-			fmt.Fprint(o, t.Borders.Header.VL)
+			io.WriteString(o, t.Borders.Header.VL)
 		} else {
-			fmt.Fprint(o, t.Borders.Header.VM)
+			io.WriteString(o, t.junction(idx, t.Borders.Header.VM,
+				t.Borders.Header.VG))
 		}
 	} else {
 		if idx == 0 {
-			fmt.Fprint(o, t.Borders.Body.VL)
+			io.WriteString(o, t.Borders.Body.VL)
 		} else {
-			fmt.Fprint(o, t.Borders.Body.VM)
+			io.WriteString(o, t.junction(idx, t.Borders.Body.VM,
+				t.Borders.Body.VG))
 		}
 	}
-	for i := 0; i < lPad; i++ {
-		fmt.Fprint(o, " ")
+	heat := t.heat(hdr, col, idx)
+	io.WriteString(o, heat)
+	writeRepeat(o, " ", lPad)
+	format := col.Format
+	if format == FmtNone {
+		if f, ok := col.Data.(formatter); ok {
+			format = f.Format()
+		}
 	}
-	if col.Format != FmtNone {
-		fmt.Fprint(o, col.Format.VT100())
+	if t.NoColors {
+		format = FmtNone
 	}
-	fmt.Fprint(o, content)
-	if col.Format != FmtNone {
-		fmt.Fprint(o, FmtNone.VT100())
+	if format != FmtNone {
+		io.WriteString(o, format.VT100())
 	}
-	for i := 0; i < rPad; i++ {
-		fmt.Fprint(o, " ")
+	if !col.Verbatim {
+		content = t.highlight(content, format)
+	}
+	io.WriteString(o, content)
+	if format != FmtNone {
+		io.WriteString(o, FmtNone.VT100())
+		io.WriteString(o, heat)
+	}
+	writeRepeat(o, " ", rPad)
+	if len(heat) > 0 {
+		io.WriteString(o, FmtNone.VT100())
 	}
 }
 
+// truncate truncates the string so that its width is at most the
+// argument width.
+func truncate(m Measure, s string, width int) string {
+	for len(s) > 0 && m(s) > width {
+		_, size := utf8.DecodeLastRuneInString(s)
+		s = s[:len(s)-size]
+	}
+	return s
+}
+
+// data returns the cached rendering of the table. The cache is
+// accessed while holding the table lock since the row additions clear
+// it. The rendering is not cached if the rows were modified during
+// the rendering.
 func (t *Tabulate) data() Data {
-	if t.asData == nil {
+	t.mu.Lock()
+	data, revision := t.asData, t.revision
+	t.mu.Unlock()
+	if data == nil {
 		builder := new(strings.Builder)
 		t.Print(builder)
-		t.asData = NewLines(builder.String())
+		data = NewLines(builder.String())
+		t.mu.Lock()
+		if t.revision == revision {
+			t.asData = data
+		}
+		t.mu.Unlock()
 	}
-	return t.asData
+	return data
 }
 
 // Width implements the Data.Width().
@@ -708,18 +1948,60 @@ func (t *Tabulate) String() string {
 	return t.data().String()
 }
 
+// MarshalText implements encoding.TextMarshaler. The function returns
+// the table rendered with its current style, or the first renderer
+// error encountered.
+func (t *Tabulate) MarshalText() ([]byte, error) {
+	var sb strings.Builder
+	if _, err := t.Fprint(&sb); err != nil {
+		return nil, err
+	}
+	return []byte(sb.String()), nil
+}
+
 // Clone creates a new tabulator sharing the headers and their
 // attributes. The new tabulator does not share the data rows with the
 // original tabulator.
 func (t *Tabulate) Clone() *Tabulate {
 	return &Tabulate{
-		Padding:     t.Padding,
-		TrimColumns: t.TrimColumns,
-		Borders:     t.Borders,
-		Measure:     t.Measure,
-		Escape:      t.Escape,
-		Defaults:    t.Defaults,
-		Headers:     t.Headers,
+		style:         t.style,
+		autoAlign:     t.autoAlign,
+		placeholder:   t.placeholder,
+		fallbacks:     t.fallbacks,
+		fixedStyle:    t.fixedStyle,
+		lineBreak:     t.lineBreak,
+		detector:      t.detector,
+		Padding:       t.Padding,
+		TrimColumns:   t.TrimColumns,
+		MaxWidth:      t.MaxWidth,
+		NoColors:      t.NoColors,
+		Deterministic: t.Deterministic,
+		reflectOpts:   t.reflectOpts,
+		highlights:    t.highlights,
+		groupSep:      t.groupSep,
+		compact:       t.compact,
+		parallel:      t.parallel,
+		jsonMode:      t.jsonMode,
+		jsonInfer:     t.jsonInfer,
+		jsonKey:       t.jsonKey,
+		jsonPrefix:    t.jsonPrefix,
+		jsonIndent:    t.jsonIndent,
+		rowNumbers:    t.rowNumbers,
+		rowStart:      t.rowStart,
+		rowHeader:     t.rowHeader,
+		totals:        t.totals,
+		separator:     t.separator,
+		emptyText:     t.emptyText,
+		rtl:           t.rtl,
+		mirrored:      t.mirrored,
+		headerGroups:  t.headerGroups,
+		Wide:          t.Wide,
+		TabWidth:      t.TabWidth,
+		Borders:       t.Borders,
+		Measure:       t.Measure,
+		Escape:        t.Escape,
+		Defaults:      t.Defaults,
+		Headers:       t.Headers,
 	}
 }
 
@@ -727,6 +2009,8 @@ func (t *Tabulate) Clone() *Tabulate {
 type Row struct {
 	Tab     *Tabulate
 	Columns []*Column
+	group   bool
+	footer  bool
 }
 
 // Height returns the row height in lines.
@@ -740,14 +2024,51 @@ func (r *Row) Height() int {
 	return max
 }
 
+// cell returns the column starting at the table column idx. The
+// function returns nil if the row does not have a column starting at
+// idx.
+func (r *Row) cell(idx int) *Column {
+	if r == nil {
+		return nil
+	}
+	var i int
+	for _, col := range r.Columns {
+		if i == idx {
+			return col
+		}
+		if i > idx {
+			break
+		}
+		i += col.span()
+	}
+	return nil
+}
+
 // Column adds a new string column to the row.
 func (r *Row) Column(label string) *Column {
 	return r.ColumnData(NewLines(label))
 }
 
+// SpanColumn adds a new string column to the row. The column spans
+// over span table columns.
+func (r *Row) SpanColumn(label string, span int) *Column {
+	col := r.newColumn(NewLines(label))
+	col.Span = span
+	return r.addColumn(col)
+}
+
 // ColumnData adds a new data column to the row.
 func (r *Row) ColumnData(data Data) *Column {
-	idx := len(r.Columns)
+	return r.addColumn(r.newColumn(data))
+}
+
+// newColumn creates a new data column for the next table column of
+// the row. The column inherits the attributes of its header.
+func (r *Row) newColumn(data Data) *Column {
+	var idx int
+	for _, col := range r.Columns {
+		idx += col.span()
+	}
 	var hdr *Column
 	if idx < len(r.Tab.Headers) {
 		hdr = r.Tab.Headers[idx]
@@ -760,25 +2081,99 @@ func (r *Row) ColumnData(data Data) *Column {
 	}
 
 	col := &Column{
-		Align:  hdr.Align,
-		Data:   data,
-		Format: hdr.Format,
+		Align:        hdr.Align,
+		Data:         formatNumber(hdr.NumberFormat, data),
+		Format:       hdr.Format,
+		NumberFormat: hdr.NumberFormat,
+		Verbatim:     hdr.Verbatim,
+		alignSet:     hdr.alignSet,
 	}
+	return col
+}
 
+// addColumn adds the column to the row. The column is added while
+// holding the table lock so it is safe to add columns to a row which
+// is concurrently rendered.
+func (r *Row) addColumn(col *Column) *Column {
+	r.Tab.mu.Lock()
 	r.Columns = append(r.Columns, col)
+	r.Tab.modified()
+	r.Tab.mu.Unlock()
 	return col
 }
 
+// replaceColumn replaces the column old of the row with col. The
+// row's columns are copied so that the concurrent renders keep their
+// snapshots of the columns.
+func (r *Row) replaceColumn(old, col *Column) {
+	columns := make([]*Column, len(r.Columns))
+	for idx, c := range r.Columns {
+		if c == old {
+			c = col
+		}
+		columns[idx] = c
+	}
+	r.Tab.mu.Lock()
+	r.Columns = columns
+	r.Tab.modified()
+	r.Tab.mu.Unlock()
+}
+
+// Set sets the data of the column starting at the table column
+// col. If the row does not have enough columns, the function adds
+// empty columns to the row until the column col can be added. An
+// existing column is replaced with a copy which has the new data. The
+// function returns the updated column.
+func (r *Row) Set(col int, data Data) *Column {
+	if c := r.cell(col); c != nil {
+		updated := *c
+		updated.Data = formatNumber(c.NumberFormat, data)
+		r.replaceColumn(c, &updated)
+		return &updated
+	}
+	var idx int
+	for _, c := range r.Columns {
+		idx += c.span()
+	}
+	if idx > col {
+		// The column col is covered by a spanning column.
+		return nil
+	}
+	for ; idx < col; idx++ {
+		r.Column("")
+	}
+	return r.ColumnData(data)
+}
+
+// emptyColumn is the shared placeholder for the missing and merged
+// cells. It must not be modified.
+var emptyColumn = &Column{}
+
 // Column defines a table column data and its attributes.
 type Column struct {
-	Align  Align
-	Data   Data
-	Format Format
+	Align        Align
+	Data         Data
+	Format       Format
+	NumberFormat string
+	Abbrev       string
+	FixedWidth   int
+	MinWidth     int
+	Weight       int
+	Span         int
+	Merge        bool
+	Group        bool
+	UnitAlign    bool
+	Verbatim     bool
+	Description  string
+	Less         Less
+	alignSet     bool
+	heatmap      *heatmap
 }
 
 // SetAlign sets the column alignment.
 func (col *Column) SetAlign(align Align) *Column {
 	col.Align = align
+	col.alignSet = true
 	return col
 }
 
@@ -788,6 +2183,134 @@ func (col *Column) SetFormat(format Format) *Column {
 	return col
 }
 
+// SetNumberFormat sets the printf format string of the numeric
+// values. The data columns which are added after the header column
+// inherit the number format and their numeric Value data, such as the
+// values of NewValue and AddRow, is formatted with it. The JSON output
+// uses the exact values.
+func (col *Column) SetNumberFormat(format string) *Column {
+	col.NumberFormat = format
+	return col
+}
+
+// SetAbbrev sets the abbreviated header label. The abbreviation is
+// used instead of the full label if the table does not fit into the
+// tabulator's MaxWidth.
+func (col *Column) SetAbbrev(abbrev string) *Column {
+	col.Abbrev = abbrev
+	return col
+}
+
+// SetWidth sets the fixed column width. The column is rendered with
+// the width regardless of its content and too wide values are
+// truncated.
+func (col *Column) SetWidth(width int) *Column {
+	col.FixedWidth = width
+	return col
+}
+
+// SetMinWidth sets the minimum column width.
+func (col *Column) SetMinWidth(width int) *Column {
+	col.MinWidth = width
+	return col
+}
+
+// SetWeight sets the column weight for width distribution. If the
+// tabulator has MaxWidth, the weighted columns are grown or shrunk so
+// that the table width matches MaxWidth. The space is distributed in
+// proportion to the column weights.
+func (col *Column) SetWeight(weight int) *Column {
+	col.Weight = weight
+	return col
+}
+
+// SetSpan sets the number of table columns the column spans over.
+func (col *Column) SetSpan(span int) *Column {
+	col.Span = span
+	return col
+}
+
+// SetVerbatim sets the column verbatim attribute. The content of the
+// verbatim columns is not escaped, truncated, highlighted, or tab
+// expanded, and the verbatim header columns are not resized by the
+// width distribution. This guarantees that preformatted blocks, such
+// as code or PEM data, are rendered unchanged apart from the
+// padding. The data columns which are added after the header column
+// inherit the verbatim attribute.
+func (col *Column) SetVerbatim(verbatim bool) *Column {
+	col.Verbatim = verbatim
+	return col
+}
+
+// SetDescription sets the header column description. The description
+// is rendered as the title attribute of the header cell in the HTML
+// output and as a numbered footnote below the table in the terminal
+// styles.
+func (col *Column) SetDescription(description string) *Column {
+	col.Description = description
+	return col
+}
+
+// SetMerge sets the column merge attribute. If merge is enabled, the
+// column values that are equal to the previous row's value are
+// rendered as empty cells.
+func (col *Column) SetMerge(merge bool) *Column {
+	col.Merge = merge
+	return col
+}
+
+// empty tests if the column does not have any content.
+func (col *Column) empty() bool {
+	for row := 0; row < col.Height(); row++ {
+		if len(col.Content(row)) > 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// SetGroup sets the column group attribute. The group column starts a
+// new column group and the box styles draw a heavier vertical rule
+// between the column groups.
+func (col *Column) SetGroup(group bool) *Column {
+	col.Group = group
+	return col
+}
+
+// SetUnitAlign sets the column unit alignment attribute. The unit
+// aligned column values are split into their numeric part and unit
+// suffix, such as "1.5 KiB" or "10 ms". The numeric parts are right
+// aligned and the suffixes are left aligned, separated by a single
+// space, so that the numbers line up regardless of their suffixes.
+func (col *Column) SetUnitAlign(unitAlign bool) *Column {
+	col.UnitAlign = unitAlign
+	return col
+}
+
+// SetLess sets the column comparison function which Sort uses to
+// order the table rows by this column.
+func (col *Column) SetLess(less Less) *Column {
+	col.Less = less
+	return col
+}
+
+// equal tests if the column has the same span and content as the
+// argument column.
+func (col *Column) equal(o *Column) bool {
+	if o == nil || col.span() != o.span() ||
+		col.Data == nil || o.Data == nil {
+		return false
+	}
+	return col.Data.String() == o.Data.String()
+}
+
+func (col *Column) span() int {
+	if col.Span < 1 {
+		return 1
+	}
+	return col.Span
+}
+
 // Width returns the column width in runes.
 func (col *Column) Width(m Measure) int {
 	if col.Data == nil {
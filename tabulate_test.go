@@ -7,8 +7,13 @@
 package tabulate
 
 import (
+	"encoding"
+	"encoding/json"
+	"errors"
+	"flag"
 	"fmt"
 	"strings"
+	"sync"
 	"testing"
 )
 
@@ -480,13 +485,11 @@ Year  Income  Expenses
 		align: TL,
 		input: borderTestBasic,
 		result: `
-        | Year | Income | Expenses |
-        |------|--------|----------|
-        | 2018 | 100    | 90       |
-        |      |        | 91       |
-        |      |        | 92       |
-        | 2019 | 110    | 85       |
-        | 2020 | 107    | 50       |
+        | Year | Income | Expenses       |
+        |------|--------|----------------|
+        | 2018 | 100    | 90<br>91<br>92 |
+        | 2019 | 110    | 85             |
+        | 2020 | 107    | 50             |
 `,
 	},
 	{
@@ -494,13 +497,11 @@ Year  Income  Expenses
 		align: MC,
 		input: borderTestBasic,
 		result: `
-        | Year | Income | Expenses |
-        |------|--------|----------|
-        |      |        |    90    |
-        | 2018 |  100   |    91    |
-        |      |        |    92    |
-        | 2019 |  110   |    85    |
-        | 2020 |  107   |    50    |
+        | Year | Income |    Expenses    |
+        |------|--------|----------------|
+        | 2018 |  100   | 90<br>91<br>92 |
+        | 2019 |  110   |       85       |
+        | 2020 |  107   |       50       |
 `,
 	},
 	{
@@ -508,13 +509,11 @@ Year  Income  Expenses
 		align: BR,
 		input: borderTestBasic,
 		result: `
-        | Year | Income | Expenses |
-        |------|--------|----------|
-        |      |        |       90 |
-        |      |        |       91 |
-        | 2018 |    100 |       92 |
-        | 2019 |    110 |       85 |
-        | 2020 |    107 |       50 |
+        | Year | Income |       Expenses |
+        |------|--------|----------------|
+        | 2018 |    100 | 90<br>91<br>92 |
+        | 2019 |    110 |             85 |
+        | 2020 |    107 |             50 |
 `,
 	},
 	{
@@ -523,9 +522,9 @@ Year  Income  Expenses
 		input: borderTestBasic,
 		result: `
         Year,Income,Expenses
-        2018,100,90
-        ,,91
-        ,,92
+        2018,100,"90
+        91
+        92"
         2019,110,85
         2020,107,50
 `,
@@ -536,9 +535,9 @@ Year  Income  Expenses
 		input: borderTestBasic,
 		result: `
         Year,Income,Expenses
-        ,,90
-        2018,100,91
-        ,,92
+        2018,100,"90
+        91
+        92"
         2019,110,85
         2020,107,50
 `,
@@ -549,9 +548,9 @@ Year  Income  Expenses
 		input: borderTestBasic,
 		result: `
         Year,Income,Expenses
-        ,,90
-        ,,91
-        2018,100,92
+        2018,100,"90
+        91
+        92"
         2019,110,85
         2020,107,50
 `,
@@ -1033,11 +1032,9 @@ Year  Income  Expenses
 		align: TL,
 		input: borderTestBodyOnly,
 		result: `
-        | 2018 | 100 | 9000 |
-        | 2019 | 110 | 85   |
-        |      |     | 86   |
-        |      |     | 86   |
-        | 2020 | 107 | 50   |
+        | 2018 | 100 | 9000           |
+        | 2019 | 110 | 85<br>86<br>86 |
+        | 2020 | 107 | 50             |
 `,
 	},
 	{
@@ -1045,11 +1042,9 @@ Year  Income  Expenses
 		align: MC,
 		input: borderTestBodyOnly,
 		result: `
-        | 2018 | 100 | 9000 |
-        |      |     |  85  |
-        | 2019 | 110 |  86  |
-        |      |     |  86  |
-        | 2020 | 107 |  50  |
+        | 2018 | 100 |      9000      |
+        | 2019 | 110 | 85<br>86<br>86 |
+        | 2020 | 107 |       50       |
 `,
 	},
 	{
@@ -1057,11 +1052,9 @@ Year  Income  Expenses
 		align: BR,
 		input: borderTestBodyOnly,
 		result: `
-        | 2018 | 100 | 9000 |
-        |      |     |   85 |
-        |      |     |   86 |
-        | 2019 | 110 |   86 |
-        | 2020 | 107 |   50 |
+        | 2018 | 100 |           9000 |
+        | 2019 | 110 | 85<br>86<br>86 |
+        | 2020 | 107 |             50 |
 `,
 	},
 	{
@@ -1070,9 +1063,9 @@ Year  Income  Expenses
 		input: borderTestBodyOnly,
 		result: `
         2018,100,9000
-        2019,110,85
-        ,,86
-        ,,86
+        2019,110,"85
+        86
+        86"
         2020,107,50
 `,
 	},
@@ -1082,9 +1075,9 @@ Year  Income  Expenses
 		input: borderTestBodyOnly,
 		result: `
         2018,100,9000
-        ,,85
-        2019,110,86
-        ,,86
+        2019,110,"85
+        86
+        86"
         2020,107,50
 `,
 	},
@@ -1094,9 +1087,9 @@ Year  Income  Expenses
 		input: borderTestBodyOnly,
 		result: `
         2018,100,9000
-        ,,85
-        ,,86
-        2019,110,86
+        2019,110,"85
+        86
+        86"
         2020,107,50
 `,
 	},
@@ -1245,6 +1238,58 @@ func TestStyles(t *testing.T) {
 	}
 }
 
+func TestParseStyle(t *testing.T) {
+	for _, style := range AllStyles() {
+		parsed, err := ParseStyle(strings.ToUpper(style.String()))
+		if err != nil {
+			t.Errorf("ParseStyle(%s) failed: %v", style, err)
+		} else if parsed != style {
+			t.Errorf("ParseStyle(%s) = %s", style, parsed)
+		}
+	}
+	if len(AllStyles()) != len(Styles) {
+		t.Errorf("AllStyles returned %d styles, expected %d",
+			len(AllStyles()), len(Styles))
+	}
+	if _, err := ParseStyle("fancy"); err == nil {
+		t.Errorf("ParseStyle accepted unknown style")
+	}
+
+	var style Style
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&style, "style", "table style")
+	if err := fs.Parse([]string{"-style", "uc"}); err != nil {
+		t.Fatalf("flag parse failed: %v", err)
+	}
+	if style != Unicode {
+		t.Errorf("style flag = %s, expected %s", style, Unicode)
+	}
+}
+
+func TestParseAlign(t *testing.T) {
+	for align, name := range aligns {
+		parsed, err := ParseAlign(strings.ToLower(name))
+		if err != nil {
+			t.Errorf("ParseAlign(%s) failed: %v", name, err)
+		} else if parsed != align {
+			t.Errorf("ParseAlign(%s) = %s", name, parsed)
+		}
+	}
+	if _, err := ParseAlign("XX"); err == nil {
+		t.Errorf("ParseAlign accepted unknown alignment")
+	}
+
+	var align Align
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.Var(&align, "align", "column alignment")
+	if err := fs.Parse([]string{"-align", "MR"}); err != nil {
+		t.Fatalf("flag parse failed: %v", err)
+	}
+	if align != MR {
+		t.Errorf("align flag = %s, expected %s", align, MR)
+	}
+}
+
 func tabulateRows(tab *Tabulate, align Align, rows []string) *Tabulate {
 
 	if len(rows[0]) > 0 {
@@ -1333,3 +1378,1387 @@ func TestWide(t *testing.T) {
 
 	match(t, sb.String(), expected, "TestWide")
 }
+
+func TestAbbrev(t *testing.T) {
+	tab := New(ASCII)
+	tab.MaxWidth = 30
+
+	tab.Header("Year")
+	tab.Header("Transactions").SetAbbrev("Txns")
+	tab.Header("Expenditures").SetAbbrev("Exp")
+
+	row := tab.Row()
+	row.Column("2018")
+	row.Column("100")
+	row.Column("90")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +------+------+--------------+
+        | Year | Txns | Expenditures |
+        +------+------+--------------+
+        | 2018 | 100  | 90           |
+        +------+------+--------------+
+`
+	match(t, sb.String(), expected, "TestAbbrev")
+
+	tab.MaxWidth = 0
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        +------+--------------+--------------+
+        | Year | Transactions | Expenditures |
+        +------+--------------+--------------+
+        | 2018 | 100          | 90           |
+        +------+--------------+--------------+
+`
+	match(t, sb.String(), expected, "TestAbbrev wide")
+}
+
+func TestWidth(t *testing.T) {
+	tab := New(ASCII)
+
+	tab.Header("Name").SetWidth(6)
+	tab.Header("Value").SetMinWidth(8)
+
+	row := tab.Row()
+	row.Column("Transactions")
+	row.Column("100")
+
+	row = tab.Row()
+	row.Column("Fee")
+	row.Column("2")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +--------+----------+
+        | Name   | Value    |
+        +--------+----------+
+        | Transa | 100      |
+        | Fee    | 2        |
+        +--------+----------+
+`
+	match(t, sb.String(), expected, "TestWidth")
+}
+
+func TestWeight(t *testing.T) {
+	tab := New(ASCII)
+	tab.MaxWidth = 40
+
+	tab.Header("ID")
+	tab.Header("Description").SetWeight(3)
+	tab.Header("Note").SetWeight(1)
+
+	row := tab.Row()
+	row.Column("1")
+	row.Column("First")
+	row.Column("-")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +----+----------------------+----------+
+        | ID | Description          | Note     |
+        +----+----------------------+----------+
+        | 1  | First                | -        |
+        +----+----------------------+----------+
+`
+	match(t, sb.String(), expected, "TestWeight")
+
+	tab.MaxWidth = 20
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        +----+--------+----+
+        | ID | Descri | No |
+        +----+--------+----+
+        | 1  | First  | -  |
+        +----+--------+----+
+`
+	match(t, sb.String(), expected, "TestWeight narrow")
+}
+
+func TestError(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Host")
+	tab.Header("Result")
+
+	row := tab.Row()
+	row.Column("a")
+	row.Column("ok")
+
+	row = tab.Row()
+	row.Column("b")
+	row.ColumnData(NewError(errors.New(
+		"connection refused while dialing the remote host over TCP")))
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +------+-----------------------------------------+
+        | Host | Result                                  |
+        +------+-----------------------------------------+
+        | a    | ok                                      |
+        | b    | ` + "\x1b[31m" + `Error: connection refused while dialing` + "\x1b[m" + ` |
+        |      | ` + "\x1b[31m" + `the remote host over TCP` + "\x1b[m" + `                |
+        +------+-----------------------------------------+
+`
+	match(t, sb.String(), expected, "TestError")
+
+	tab.Headers[1].SetMinWidth(60)
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        +------+--------------------------------------------------------------+
+        | Host | Result                                                       |
+        +------+--------------------------------------------------------------+
+        | a    | ok                                                           |
+        | b    | ` + "\x1b[31m" + `Error: connection refused while dialing the remote host over` + "\x1b[m" + ` |
+        |      | ` + "\x1b[31m" + `TCP` + "\x1b[m" + `                                                          |
+        +------+--------------------------------------------------------------+
+`
+	match(t, sb.String(), expected, "TestError wide")
+
+	tab.Headers[1].FixedWidth = 20
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        +------+----------------------+
+        | Host | Result               |
+        +------+----------------------+
+        | a    | ok                   |
+        | b    | ` + "\x1b[31m" + `Error: connection` + "\x1b[m" + `    |
+        |      | ` + "\x1b[31m" + `refused while` + "\x1b[m" + `        |
+        |      | ` + "\x1b[31m" + `dialing the remote` + "\x1b[m" + `   |
+        |      | ` + "\x1b[31m" + `host over TCP` + "\x1b[m" + `        |
+        +------+----------------------+
+`
+	match(t, sb.String(), expected, "TestError fixed")
+
+	tab = New(Plain)
+	tab.SetWidthFunc(func(s string) int {
+		return 2 * len(s)
+	})
+	tab.Row().ColumnData(NewError(errors.New("no route to host")))
+	var lines []string
+	for _, line := range strings.Split(tab.Render(Plain), "\n") {
+		if len(line) > 0 {
+			lines = append(lines, stripANSI(strings.TrimSpace(line)))
+		}
+	}
+	if len(lines) != 2 || lines[0] != "Error: no route to" ||
+		lines[1] != "host" {
+		t.Errorf("TestError measure: got %q", lines)
+	}
+}
+
+func TestSpan(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Year")
+	tab.Header("Income").SetAlign(TR)
+	tab.Header("Expenses").SetAlign(TR)
+
+	row := tab.Row()
+	row.SpanColumn("Quarterly report", 3).SetAlign(TC)
+
+	row = tab.Row()
+	row.Column("2018")
+	row.Column("100")
+	row.Column("90")
+
+	row = tab.Row()
+	row.SpanColumn("Total", 2)
+	row.Column("90")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +------+--------+----------+
+        | Year | Income | Expenses |
+        +------+--------+----------+
+        |     Quarterly report     |
+        | 2018 |    100 |       90 |
+        | Total         |       90 |
+        +------+--------+----------+
+`
+	match(t, sb.String(), expected, "TestSpan")
+}
+
+func TestMerge(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Region").SetMerge(true)
+	tab.Header("City")
+
+	for _, r := range [][]string{
+		{"Europe", "Helsinki"},
+		{"Europe", "Paris"},
+		{"Asia", "Tokyo"},
+		{"Europe", "Rome"},
+	} {
+		row := tab.Row()
+		row.Column(r[0])
+		row.Column(r[1])
+	}
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +--------+----------+
+        | Region | City     |
+        +--------+----------+
+        | Europe | Helsinki |
+        |        | Paris    |
+        | Asia   | Tokyo    |
+        | Europe | Rome     |
+        +--------+----------+
+`
+	match(t, sb.String(), expected, "TestMerge")
+}
+
+func TestUnitAlign(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("File")
+	tab.Header("Size").SetUnitAlign(true)
+
+	for _, r := range [][]string{
+		{"a", "1.5 KiB"},
+		{"b", "512 B"},
+		{"c", "12MiB"},
+		{"d", "0"},
+	} {
+		row := tab.Row()
+		row.Column(r[0])
+		row.Column(r[1])
+	}
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +------+---------+
+        | File | Size    |
+        +------+---------+
+        | a    | 1.5 KiB |
+        | b    | 512 B   |
+        | c    |  12MiB  |
+        | d    |   0     |
+        +------+---------+
+`
+	match(t, sb.String(), expected, "TestUnitAlign")
+}
+
+func TestID(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("ID")
+	tab.Header("Name")
+
+	row := tab.Row()
+	row.ColumnData(NewID("3f2504e0-4f89-11d3-9a0c-0305e82c3301", 8))
+	row.Column("a")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +-----------+------+
+        | ID        | Name |
+        +-----------+------+
+        | 3f2504e0… | a    |
+        +-----------+------+
+`
+	match(t, sb.String(), expected, "TestID")
+
+	tab.Wide = true
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        +--------------------------------------+------+
+        | ID                                   | Name |
+        +--------------------------------------+------+
+        | 3f2504e0-4f89-11d3-9a0c-0305e82c3301 | a    |
+        +--------------------------------------+------+
+`
+	match(t, sb.String(), expected, "TestID wide")
+
+	tab = New(CSV)
+	tab.Row().ColumnData(NewID("3f2504e0-4f89-11d3-9a0c-0305e82c3301", 8))
+	sb.Reset()
+	tab.Print(&sb)
+	match(t, sb.String(), "3f2504e0-4f89-11d3-9a0c-0305e82c3301", "TestID CSV")
+}
+
+func TestGroup(t *testing.T) {
+	tab := New(UnicodeLight)
+	tab.Header("Host")
+	tab.Header("Zone")
+	tab.Header("Req").SetGroup(true)
+	tab.Header("Err")
+
+	row := tab.Row()
+	row.Column("a")
+	row.Column("eu")
+	row.Column("100")
+	row.Column("2")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        ┌──────┬──────┰─────┬─────┐
+        │ Host │ Zone ┃ Req │ Err │
+        ├──────┼──────╂─────┼─────┤
+        │ a    │ eu   ┃ 100 │ 2   │
+        └──────┴──────┸─────┴─────┘
+`
+	match(t, sb.String(), expected, "TestGroup")
+
+	tab = New(ASCII)
+	tab.Header("A")
+	tab.Header("B").SetGroup(true)
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        +---+---+
+        | A | B |
+        +---+---+
+`
+	match(t, sb.String(), expected, "TestGroup ASCII")
+}
+
+func TestTabs(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Key")
+	tab.Header("Value")
+
+	row := tab.Row()
+	row.Column("a\tb")
+	row.Column("x\ty\tz")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +-----------+-------------------+
+        | Key       | Value             |
+        +-----------+-------------------+
+        | a       b | x       y       z |
+        +-----------+-------------------+
+`
+	match(t, sb.String(), expected, "TestTabs")
+
+	tab.TabWidth = 4
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        +-------+-----------+
+        | Key   | Value     |
+        +-------+-----------+
+        | a   b | x   y   z |
+        +-------+-----------+
+`
+	match(t, sb.String(), expected, "TestTabs 4")
+
+	tab.Row().ColumnData(&formatted{NewText("c\td")})
+	sb.Reset()
+	tab.Print(&sb)
+	if !strings.Contains(sb.String(), "\x1b[31mc   d\x1b[m") {
+		t.Errorf("TestTabs format: got %q", sb.String())
+	}
+}
+
+// formatted implements Data with a format.
+type formatted struct {
+	*Lines
+}
+
+func (f *formatted) Format() Format {
+	return FmtRed
+}
+
+func TestSummary(t *testing.T) {
+	tab := tabulate(New(ASCII), TL, `Year,Income
+2018,100
+2019,110
+2020,107`)
+	tab.SetSummary(SummaryRows)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +------+--------+
+        | Year | Income |
+        +------+--------+
+        | 2018 | 100    |
+        | 2019 | 110    |
+        | 2020 | 107    |
+        +------+--------+
+        (3 rows)
+`
+	match(t, sb.String(), expected, "TestSummary")
+
+	tab = New(CSV)
+	tab.SetSummary(SummaryRows)
+	tab.Row().Column("a")
+	sb.Reset()
+	tab.Print(&sb)
+	match(t, sb.String(), "a", "TestSummary CSV")
+}
+
+func TestLineFilter(t *testing.T) {
+	tab := tabulate(New(ASCII), TL, `Year,Income
+2018,100`)
+	tab.SetSummary(SummaryRows)
+	tab.SetLineFilter(func(line string) string {
+		return "INFO " + line
+	})
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        INFO +------+--------+
+        INFO | Year | Income |
+        INFO +------+--------+
+        INFO | 2018 | 100    |
+        INFO +------+--------+
+        INFO (1 row)
+`
+	match(t, sb.String(), expected, "TestLineFilter")
+}
+
+func TestMaxRows(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("N")
+	for i := 0; i < 12347; i++ {
+		tab.Row().Column(fmt.Sprintf("%d", i))
+	}
+	tab.SetMaxRows(2)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +---+
+        | N |
+        +---+
+        | 0 |
+        | 1 |
+        +---+
+        ... and 12,345 more rows
+`
+	match(t, sb.String(), expected, "TestMaxRows")
+
+	for _, test := range []struct {
+		n        int
+		expected string
+	}{
+		{0, "0"},
+		{999, "999"},
+		{1000, "1,000"},
+		{-1234567, "-1,234,567"},
+	} {
+		if s := thousands(test.n); s != test.expected {
+			t.Errorf("thousands(%d) = %s, expected %s", test.n, s,
+				test.expected)
+		}
+	}
+}
+
+func TestAutoAlign(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.Header("Count")
+	tab.Header("Price").SetAlign(ML)
+	tab.Header("Code")
+	for _, r := range [][]string{
+		{"apple", "1", "0.5", "007"},
+		{"banana", "12", "", "42"},
+		{"cherry", "123", "1.25", "x1"},
+	} {
+		row := tab.Row()
+		for _, c := range r {
+			row.Column(c)
+		}
+	}
+	tab.SetAutoAlign(true)
+	tab.HideColumn(3)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +--------+-------+-------+
+        | Name   | Count | Price |
+        +--------+-------+-------+
+        | apple  |     1 | 0.5   |
+        | banana |    12 |       |
+        | cherry |   123 | 1.25  |
+        +--------+-------+-------+
+`
+	match(t, sb.String(), expected, "TestAutoAlign")
+}
+
+func TestPlaceholder(t *testing.T) {
+	input := `Year,Income,Expenses
+2018,,90
+2019,110`
+
+	tab := tabulate(New(ASCII), TL, input)
+	tab.SetPlaceholder("-")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +------+--------+----------+
+        | Year | Income | Expenses |
+        +------+--------+----------+
+        | 2018 | -      | 90       |
+        | 2019 | 110    | -        |
+        +------+--------+----------+
+`
+	match(t, sb.String(), expected, "TestPlaceholder")
+
+	tab = tabulate(New(CSV), TL, input)
+	tab.SetPlaceholder("n/a")
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        Year,Income,Expenses
+        2018,n/a,90
+        2019,110,n/a
+`
+	match(t, sb.String(), expected, "TestPlaceholder CSV")
+
+	tab = tabulate(New(JSON), TL, input)
+	tab.SetPlaceholder("n/a")
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `{"2018":["n/a","90"],"2019":["110","n/a"]}`
+	match(t, sb.String(), expected, "TestPlaceholder JSON")
+
+	tab = tabulate(New(Plain), TL, `A,B,C
+1,,2
+3,4`)
+	tab.SetPlaceholder("n/a")
+	match(t, tab.String(), `
+         A  B    C
+         1  n/a  2
+         3  4    n/a
+`, "TestPlaceholder width")
+}
+
+func TestWrapped(t *testing.T) {
+	tests := []struct {
+		text     string
+		width    int
+		expected []string
+	}{
+		{"", 10, []string{""}},
+		{"short", 10, []string{"short"}},
+		{"the quick brown fox jumps", 10,
+			[]string{"the quick", "brown fox", "jumps"}},
+		{"a verylongwordindeed b", 5, []string{"a", "verylongwordindeed", "b"}},
+		{"first line\nsecond", 20, []string{"first line", "second"}},
+	}
+	for _, test := range tests {
+		lines := NewWrapped(test.text, test.width).Lines
+		if strings.Join(lines, "|") != strings.Join(test.expected, "|") {
+			t.Errorf("NewWrapped(%q, %d) = %q, expected %q",
+				test.text, test.width, lines, test.expected)
+		}
+	}
+}
+
+func TestLazy(t *testing.T) {
+	var calls int
+	tab := New(Plain)
+	row := tab.Row()
+	row.Column("host")
+	row.ColumnData(NewLazy(func() string {
+		calls++
+		return "example.com"
+	}))
+	if calls != 0 {
+		t.Fatalf("lazy value evaluated before Print")
+	}
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	tab.Print(&sb)
+	if calls != 1 {
+		t.Errorf("lazy value evaluated %d times", calls)
+	}
+	match(t, sb.String(), `
+        host  example.com
+        host  example.com
+`, "TestLazy")
+}
+
+func TestAddRow(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.Header("Count").SetAlign(MR)
+	tab.Header("Ratio")
+	tab.AddRow("alpha", 42, NewFloat(0.5, 2))
+	tab.AddRow("beta\ngamma", 7, true)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +-------+-------+-------+
+        | Name  | Count | Ratio |
+        +-------+-------+-------+
+        | alpha |    42 | 0.50  |
+        | beta  |     7 | true  |
+        | gamma |       |       |
+        +-------+-------+-------+
+`, "TestAddRow")
+}
+
+func TestAddRowConcurrent(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Worker")
+	tab.Header("Row")
+
+	var wg sync.WaitGroup
+	for w := 0; w < 8; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				tab.AddRow(w, i)
+			}
+		}(w)
+	}
+	wg.Wait()
+
+	if len(tab.Rows) != 800 {
+		t.Errorf("TestAddRowConcurrent: got %d rows, expected 800",
+			len(tab.Rows))
+	}
+	for _, row := range tab.Rows {
+		if len(row.Columns) != 2 {
+			t.Errorf("TestAddRowConcurrent: got %d columns, expected 2",
+				len(row.Columns))
+		}
+	}
+}
+
+func TestMaxRowsConcurrent(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Worker")
+	tab.Header("Row")
+	tab.SetMaxRows(10)
+	tab.AddRow(0, 0)
+
+	var wg sync.WaitGroup
+	for w := 0; w < 4; w++ {
+		wg.Add(1)
+		go func(w int) {
+			defer wg.Done()
+			for i := 0; i < 100; i++ {
+				tab.AddRow(w, i)
+			}
+		}(w)
+	}
+	for i := 0; i < 20; i++ {
+		var sb strings.Builder
+		tab.Print(&sb)
+	}
+	wg.Wait()
+
+	if len(tab.Rows) != 401 {
+		t.Errorf("TestMaxRowsConcurrent: got %d rows, expected 401",
+			len(tab.Rows))
+	}
+}
+
+func TestMutateCells(t *testing.T) {
+	tab := New(ASCII)
+	tab.SetHeaders("Name", "Count", "Note")
+	tab.AddRow("alpha", 1)
+	tab.AddRow("beta", 2)
+	tab.AddRow("gamma", 3)
+
+	tab.At(0, 1).Data = NewValue(10)
+	if tab.At(0, 2) != nil {
+		t.Errorf("At(0, 2) returned non-nil column for missing cell")
+	}
+	if tab.At(5, 0) != nil {
+		t.Errorf("At(5, 0) returned non-nil column for missing row")
+	}
+	tab.Rows[2].Set(0, NewText("delta"))
+	tab.Rows[2].Set(2, NewText("fixed"))
+	tab.RemoveRow(1)
+	tab.RemoveRow(7)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +-------+-------+-------+
+        | Name  | Count | Note  |
+        +-------+-------+-------+
+        | alpha | 10    |       |
+        | delta | 3     | fixed |
+        +-------+-------+-------+
+`, "TestMutateCells")
+}
+
+func TestAtConcurrent(t *testing.T) {
+	tab := New(Plain)
+	for i := 0; i < 100; i++ {
+		tab.AddRow(i)
+	}
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			tab.RemoveRow(0)
+		}
+	}()
+	for i := 0; i < 100; i++ {
+		tab.At(50, 0)
+	}
+	<-done
+	if tab.At(0, 0) != nil {
+		t.Errorf("TestAtConcurrent: rows not removed")
+	}
+}
+
+type failWriter struct {
+	limit int
+}
+
+func (w *failWriter) Write(p []byte) (int, error) {
+	if len(p) > w.limit {
+		n := w.limit
+		w.limit = 0
+		return n, errors.New("write failed")
+	}
+	w.limit -= len(p)
+	return len(p), nil
+}
+
+func TestFprint(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Name")
+	tab.AddRow("alpha")
+
+	var sb strings.Builder
+	n, err := tab.Fprint(&sb)
+	if err != nil {
+		t.Fatalf("Fprint failed: %v", err)
+	}
+	if n != sb.Len() {
+		t.Errorf("Fprint returned %d, wrote %d bytes", n, sb.Len())
+	}
+
+	n, err = tab.Fprint(&failWriter{limit: 5})
+	if err == nil {
+		t.Errorf("Fprint did not return write error")
+	}
+	if n != 5 {
+		t.Errorf("Fprint returned %d, expected 5", n)
+	}
+}
+
+func TestRender(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.Header("Count").SetAlign(MR)
+	tab.AddRow("alpha", 42)
+
+	match(t, tab.Render(CSV), `
+        Name,Count
+        alpha,42
+`, "TestRender")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +-------+-------+
+        | Name  | Count |
+        +-------+-------+
+        | alpha |    42 |
+        +-------+-------+
+`, "TestRender")
+	if tab.Style() != ASCII {
+		t.Errorf("Render changed table style to %s", tab.Style())
+	}
+}
+
+func TestRenderConcurrent(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Name")
+	tab.Header("Count").SetAlign(MR)
+	tab.AddRow("alpha", 42)
+
+	var wg sync.WaitGroup
+	for _, style := range []Style{CSV, JSON, Unicode, Github} {
+		wg.Add(1)
+		go func(style Style) {
+			defer wg.Done()
+			for i := 0; i < 20; i++ {
+				tab.Render(style)
+			}
+		}(style)
+	}
+	for i := 0; i < 20; i++ {
+		var sb strings.Builder
+		tab.Print(&sb)
+		match(t, sb.String(), `
+        +-------+-------+
+        | Name  | Count |
+        +-------+-------+
+        | alpha |    42 |
+        +-------+-------+
+`, "TestRenderConcurrent")
+	}
+	wg.Wait()
+}
+
+func TestAppend(t *testing.T) {
+	tab := New(ASCII)
+	tab.SetHeaders("Shard", "Count")
+	tab.AddRow("a", 1)
+
+	other := New(ASCII)
+	other.SetHeaders("Count", "Shard", "Errors")
+	other.AddRow(2, "b", 0)
+	other.AddRow(3, "c", 5)
+	tab.Append(other)
+
+	raw := New(ASCII)
+	raw.AddRow("x", 9, "ignored")
+	tab.Append(raw, 1, 0, -1)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +-------+-------+--------+
+        | Shard | Count | Errors |
+        +-------+-------+--------+
+        | a     | 1     |        |
+        | b     | 2     | 0      |
+        | c     | 3     | 5      |
+        | 9     | x     |        |
+        +-------+-------+--------+
+`, "TestAppend")
+
+	tab = New(ASCII)
+	tab.SetHeaders("Name", "Value", "Unit")
+	other = New(ASCII)
+	other.SetHeaders("Name", "Value", "Unit")
+	other.Group("group")
+	row := other.Row()
+	row.Column("total").SetSpan(2).SetAlign(MR)
+	row.Column("ms").SetFormat(FmtBold)
+	tab.Append(other)
+
+	if len(tab.Rows) != 2 || !tab.Rows[0].group {
+		t.Fatalf("TestAppend: group row not copied")
+	}
+	cols := tab.Rows[1].Columns
+	if len(cols) != 2 || cols[0].Span != 2 || cols[0].Align != MR ||
+		cols[1].Format != FmtBold {
+		t.Errorf("TestAppend: cell attributes not copied")
+	}
+	if cols[0] == other.Rows[1].Columns[0] {
+		t.Errorf("TestAppend: cell shared with the source table")
+	}
+}
+
+func TestRowGroup(t *testing.T) {
+	tab := New(ASCII)
+	tab.SetHeaders("Pod", "Status")
+	tab.Group("default")
+	tab.AddRow("web-1", "Running")
+	tab.AddRow("web-2", "Pending")
+	tab.Group("kube-system")
+	tab.AddRow("dns", "Running")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +-------+---------+
+        | Pod   | Status  |
+        +-------+---------+
+        | default         |
+        | web-1 | Running |
+        | web-2 | Pending |
+        | kube-system     |
+        | dns   | Running |
+        +-------+---------+
+`, "TestRowGroup")
+
+	sb.Reset()
+	tab.SetGroupSeparator(true)
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +-------+---------+
+        | Pod   | Status  |
+        +-------+---------+
+        | default         |
+        | web-1 | Running |
+        | web-2 | Pending |
+        +-------+---------+
+        | kube-system     |
+        | dns   | Running |
+        +-------+---------+
+`, "TestRowGroup separator")
+
+	match(t, tab.Render(CSV), `
+        Pod,Status
+        web-1,Running
+        web-2,Pending
+        dns,Running
+`, "TestRowGroup CSV")
+
+	match(t, tab.Render(JSON), `
+        {"dns":"Running","web-1":"Running","web-2":"Pending"}
+`, "TestRowGroup JSON")
+
+	tab.SetJSONMode(JSONTable)
+	match(t, tab.Render(JSON), `
+        {"headers":["Pod","Status"],"rows":[["web-1","Running"],["web-2","Pending"],["dns","Running"]]}
+`, "TestRowGroup JSONTable")
+
+	b, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("json.Marshal failed: %v", err)
+	}
+	expected := `{"headers":["Pod","Status"],"rows":[["web-1","Running"],` +
+		`["web-2","Pending"],["dns","Running"]]}`
+	if string(b) != expected {
+		t.Errorf("TestRowGroup MarshalJSON: got %s, expected %s", b, expected)
+	}
+}
+
+func TestHeaderGroup(t *testing.T) {
+	tab := New(Unicode)
+	tab.HeaderGroup("", 1)
+	tab.HeaderGroup("2023", 2)
+	tab.HeaderGroup("2024", 2)
+	tab.SetHeaders("Source", "Q1", "Q2", "Q1", "Q2")
+	tab.AddRow("Salary", 100, 110, 120, 130)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        ┏━━━━━━━━┳━━━━━━━━━━━┳━━━━━━━━━━━┓
+        ┃        ┃ 2023      ┃ 2024      ┃
+        ┣━━━━━━━━╋━━━━━┳━━━━━╋━━━━━┳━━━━━┫
+        ┃ Source ┃ Q1  ┃ Q2  ┃ Q1  ┃ Q2  ┃
+        ┡━━━━━━━━╇━━━━━╇━━━━━╇━━━━━╇━━━━━┩
+        │ Salary │ 100 │ 110 │ 120 │ 130 │
+        └────────┴─────┴─────┴─────┴─────┘
+`, "TestHeaderGroup")
+
+	tab = New(ASCII)
+	tab.HeaderGroup("Long group label", 2)
+	tab.SetHeaders("A", "B", "C")
+	tab.AddRow(1, 2, 3)
+
+	sb.Reset()
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +------------------+---+
+        | Long group label |   |
+        +---+--------------+---+
+        | A | B            | C |
+        +---+--------------+---+
+        | 1 | 2            | 3 |
+        +---+--------------+---+
+`, "TestHeaderGroup wide")
+
+	tab = New(ASCII)
+	tab.HeaderGroup("", 1)
+	tab.HeaderGroup("2023", 2)
+	tab.HeaderGroup("2024", 2)
+	tab.SetHeaders("Source", "Q1", "Q2", "Q1", "Q2")
+	tab.AddRow("Salary", 100, 110, 120, 130)
+	tab.HideColumn(2)
+	match(t, tab.Render(ASCII), `
+        +--------+------+-----------+
+        |        | 2023 | 2024      |
+        +--------+------+-----+-----+
+        | Source | Q1   | Q1  | Q2  |
+        +--------+------+-----+-----+
+        | Salary | 100  | 120 | 130 |
+        +--------+------+-----+-----+
+`, "TestHeaderGroup hidden")
+
+	match(t, tab.Render(CSV), `
+        Source,Q1,Q1,Q2
+        Salary,100,120,130
+`, "TestHeaderGroup CSV")
+}
+
+func TestCells(t *testing.T) {
+	tab := New(Plain)
+	tab.SetHeaders("Name", "Count", "Note")
+	tab.AddRow("alpha", 1)
+	tab.Row().SpanColumn("spanning", 2)
+	tab.Rows[1].Column("end")
+
+	var result []string
+	tab.Cells(func(ref CellRef, data Data) bool {
+		result = append(result,
+			fmt.Sprintf("%d/%d=%s", ref.Row, ref.Col, data.String()))
+		return true
+	})
+	expected := "-1/0=Name -1/1=Count -1/2=Note 0/0=alpha 0/1=1 " +
+		"1/0=spanning 1/2=end"
+	if strings.Join(result, " ") != expected {
+		t.Errorf("TestCells: got %q, expected %q",
+			strings.Join(result, " "), expected)
+	}
+
+	var count int
+	tab.Cells(func(ref CellRef, data Data) bool {
+		count++
+		return ref.Row < 0
+	})
+	if count != 4 {
+		t.Errorf("TestCells: iteration did not stop, visited %d cells", count)
+	}
+}
+
+func TestNestedInvalidate(t *testing.T) {
+	sub := New(Plain)
+	sub.Header("Name")
+	sub.AddRow("alpha")
+
+	if sub.Height() != 2 {
+		t.Errorf("TestNestedInvalidate: height %d, expected 2", sub.Height())
+	}
+	sub.AddRow("beta")
+	if sub.Height() != 3 {
+		t.Errorf("TestNestedInvalidate: height %d, expected 3", sub.Height())
+	}
+	sub.RemoveRow(0)
+	sub.Rows[0].Set(0, NewText("a-longer-name"))
+	if sub.Content(1) != " a-longer-name " {
+		t.Errorf("TestNestedInvalidate: got %q", sub.Content(1))
+	}
+
+	sub.Padding = 0
+	sub.Invalidate()
+	if sub.Content(1) != "a-longer-name" {
+		t.Errorf("TestNestedInvalidate: got %q", sub.Content(1))
+	}
+}
+
+func TestSliceInvalidate(t *testing.T) {
+	arr := NewSlice(80)
+	arr.Append(NewText("a"))
+	if w := arr.Width(MeasureRunes); w != 1 {
+		t.Errorf("TestSliceInvalidate: width %d, expected 1", w)
+	}
+	arr.Append(NewText("bcd"))
+	if w := arr.Width(MeasureRunes); w != 5 {
+		t.Errorf("TestSliceInvalidate: width %d, expected 5", w)
+	}
+	if h := arr.Height(); h != 1 {
+		t.Errorf("TestSliceInvalidate: height %d, expected 1", h)
+	}
+
+	lines := NewText("abc")
+	if w := lines.Width(MeasureRunes); w != 3 {
+		t.Errorf("TestSliceInvalidate: width %d, expected 3", w)
+	}
+	lines.Lines = []string{"abcdef"}
+	if w := lines.Width(MeasureRunes); w != 6 {
+		t.Errorf("TestSliceInvalidate: width %d, expected 6", w)
+	}
+	lines.Lines[0] = "abcdefgh"
+	if w := lines.Width(MeasureRunes); w != 8 {
+		t.Errorf("TestSliceInvalidate: width %d, expected 8", w)
+	}
+}
+
+func TestWidthCache(t *testing.T) {
+	lines := NewText("ab\u00e4")
+	if w := lines.Width(MeasureRunes); w != 3 {
+		t.Errorf("TestWidthCache: width %d, expected 3", w)
+	}
+	if !lines.cache.valid[measureRunes] || lines.cache.valid[measureUnicode] {
+		t.Errorf("TestWidthCache: MeasureRunes not cached")
+	}
+	wide := func(s string) int {
+		return 2 * MeasureRunes(s)
+	}
+	if w := lines.Width(wide); w != 6 {
+		t.Errorf("TestWidthCache: width %d, expected 6", w)
+	}
+	if w := lines.Width(MeasureRunes); w != 3 {
+		t.Errorf("TestWidthCache: width %d, expected 3", w)
+	}
+}
+
+func TestNewValueFormat(t *testing.T) {
+	values := []interface{}{
+		42, int64(-7), int32(5), uint(3), uint64(1 << 63), uint32(9),
+		true, 3.14, 1e21, -0.0001, "text", float32(1.5), []int{1, 2},
+	}
+	for _, v := range values {
+		expected := fmt.Sprintf("%v", v)
+		if got := NewValue(v).String(); got != expected {
+			t.Errorf("NewValue(%v): got %q, expected %q", v, got, expected)
+		}
+	}
+}
+
+func TestSetHeaders(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Old")
+	cols := tab.SetHeaders("Year", "Income", "Source")
+	if len(cols) != 3 || len(tab.Headers) != 3 {
+		t.Fatalf("SetHeaders returned %d columns, table has %d headers",
+			len(cols), len(tab.Headers))
+	}
+	cols[2].SetFormat(FmtBold)
+	tab.SetAligns(ML, MR, ML, MR)
+	tab.AddRow("2018", "100", "Salary")
+	tab.AddRow("2019", "1100", "Consultation")
+
+	var sb strings.Builder
+	tab.NoColors = true
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +------+--------+--------------+
+        | Year | Income | Source       |
+        +------+--------+--------------+
+        | 2018 |    100 | Salary       |
+        | 2019 |   1100 | Consultation |
+        +------+--------+--------------+
+`, "TestSetHeaders")
+}
+
+func TestMarshalText(t *testing.T) {
+	sub := New(Plain)
+	sub.Header("Name")
+	sub.AddRow("alpha")
+
+	var m encoding.TextMarshaler = sub
+	data, err := m.MarshalText()
+	if err != nil {
+		t.Fatalf("MarshalText failed: %v", err)
+	}
+	if string(data) != sub.Render(Plain) {
+		t.Errorf("MarshalText: got %q, expected %q", data, sub.Render(Plain))
+	}
+
+	tab := New(ASCII)
+	tab.Header("Key")
+	tab.Header("Value")
+	err = Reflect(tab, 0, nil, map[string]interface{}{
+		"table": sub,
+	})
+	if err != nil {
+		t.Fatalf("Reflect failed: %v", err)
+	}
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +-------+---------+
+        | Key   | Value   |
+        +-------+---------+
+        | table |  Name   |
+        |       |  alpha  |
+        +-------+---------+
+`, "TestMarshalText")
+}
+
+func TestCSVEscape(t *testing.T) {
+	tab := New(CSV)
+	tab.Header("Name")
+	tab.Header("Address")
+	tab.AddRow("Smith, John", `Main St "1"`)
+	tab.AddRow(" leading", "plain")
+	tab.AddRow("a,b", NewLinesData([]string{"line1", "line2"}))
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := "Name,Address\r\n" +
+		"\"Smith, John\",\"Main St \"\"1\"\"\"\r\n" +
+		"\" leading\",plain\r\n" +
+		"\"a,b\",\"line1\r\nline2\"\r\n"
+	if sb.String() != expected {
+		t.Errorf("TestCSVEscape: got %q, expected %q", sb.String(), expected)
+	}
+}
+
+func TestGithubEscape(t *testing.T) {
+	tab := New(Github)
+	tab.Header("Op")
+	tab.Header("Note")
+	tab.AddRow("a|b", "`code")
+	tab.Row().ColumnData(NewValue("x\ny"))
+	tab.AddRow("first\nsecond", "p|q\nr")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        | Op              | Note      |
+        |-----------------|-----------|
+        | a\|b            | \`+"`"+`code    |
+        | x<br>y          |           |
+        | first<br>second | p\|q<br>r |
+`, "TestGithubEscape")
+}
+
+func TestNull(t *testing.T) {
+	tab := New(Plain)
+	tab.Header("Name")
+	tab.Header("Value")
+	tab.AddRow("null", nil)
+	tab.AddRow("empty", "")
+
+	match(t, tab.Render(Plain), `
+         Name   Value
+         null   <nil>
+         empty
+`, "TestNull")
+	match(t, tab.Render(CSV), `
+        Name,Value
+        null,
+        empty,
+`, "TestNull CSV")
+	match(t, tab.Render(JSON), `
+        {"empty":"","null":null}
+`, "TestNull JSON")
+
+	tab.SetPlaceholder("-")
+	match(t, tab.Render(Plain), `
+         Name   Value
+         null   -
+         empty  -
+`, "TestNull placeholder")
+	match(t, tab.Render(CSV), `
+        Name,Value
+        null,
+        empty,-
+`, "TestNull placeholder CSV")
+	match(t, tab.Render(JSON), `
+        {"empty":"-","null":null}
+`, "TestNull placeholder JSON")
+
+	data := map[string]*int{
+		"Ptr": nil,
+	}
+	tab = New(JSON)
+	if err := Reflect(tab, 0, nil, data); err != nil {
+		t.Fatalf("Reflect failed: %v", err)
+	}
+	match(t, tab.Render(JSON), `
+        {"Ptr":null}
+`, "TestNull Reflect")
+
+	type Item struct {
+		Name   string
+		Parent *Item
+	}
+	tab = New(JSON)
+	if err := Reflect(tab, 0, nil, &Item{Name: "item"}); err != nil {
+		t.Fatalf("Reflect failed: %v", err)
+	}
+	match(t, tab.Render(JSON), `
+        {"Name":"item","Parent":null}
+`, "TestNull Reflect struct")
+	match(t, tab.Render(Plain), `
+         Name    item
+         Parent  <nil>
+`, "TestNull Reflect struct Plain")
+}
+
+func TestVerbatim(t *testing.T) {
+	tab := New(Github)
+	tab.Header("Name")
+	tab.Header("Code").SetVerbatim(true)
+	tab.AddRow("pipe", "a|b")
+	tab.AddRow("tab", "x\ty")
+	row := tab.Row()
+	row.Column("cell|escaped")
+	row.Column("`q`")
+	row = tab.Row()
+	row.Column("cell|verbatim").SetVerbatim(true)
+	row.Column("")
+
+	match(t, tab.String(), `
+        | Name          | Code |
+        |---------------|------|
+        | pipe          | a|b  |
+        | tab           | x	y  |
+        | cell\|escaped | `+"`q`"+`  |
+        | cell|verbatim |      |
+`, "TestVerbatim")
+
+	tab = New(Plain)
+	tab.MaxWidth = 10
+	tab.Header("Key").SetWeight(1)
+	tab.Header("PEM").SetWeight(1).SetVerbatim(true)
+	tab.AddRow("k", "-----BEGIN-----")
+	match(t, tab.String(), `
+         K  PEM
+         k  -----BEGIN-----
+`, "TestVerbatim MaxWidth")
+}
+
+func TestWidthFunc(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Key")
+	tab.Header("Value")
+	tab.AddRow("a", "\x1b[1mWWW\x1b[0m")
+
+	var calls []string
+	tab.SetWidthFunc(func(s string) int {
+		calls = append(calls, s)
+		return len(s) + strings.Count(s, "W")
+	})
+	match(t, tab.String(), `
+        +-----+--------+
+        | Key | Value  |
+        +-----+--------+
+        | a   | `+"\x1b[1mWWW\x1b[0m"+` |
+        +-----+--------+
+`, "TestWidthFunc")
+	for _, call := range calls {
+		if strings.IndexByte(call, 0x1b) >= 0 {
+			t.Errorf("TestWidthFunc: width function called with %q", call)
+		}
+	}
+
+	tab.SetWidthFunc(nil)
+	match(t, tab.String(), `
+        +-----+-------+
+        | Key | Value |
+        +-----+-------+
+        | a   | `+"\x1b[1mWWW\x1b[0m"+`   |
+        +-----+-------+
+`, "TestWidthFunc default")
+}
+
+func TestRowConcurrentRender(t *testing.T) {
+	tab := New(Plain)
+	tab.SetHeaders("Name", "Value", "Unit")
+
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 200; i++ {
+			row := tab.Row()
+			row.Column("name")
+			row.Set(1, NewValue(i))
+			row.Set(1, NewValue(i+1))
+			row.SpanColumn("span", 2)
+		}
+	}()
+	for i := 0; i < 20; i++ {
+		_ = tab.String()
+	}
+	<-done
+
+	if len(tab.Rows) != 200 {
+		t.Fatalf("TestRowConcurrentRender: got %d rows, expected 200",
+			len(tab.Rows))
+	}
+	if v := tab.At(199, 1).Data.String(); v != "200" {
+		t.Errorf("TestRowConcurrentRender: got value %q, expected 200", v)
+	}
+}
@@ -0,0 +1,19 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+//go:build !darwin && !dragonfly && !freebsd && !linux && !netbsd && !openbsd
+
+package tabulate
+
+import (
+	"os"
+)
+
+// terminalSize returns the height of the terminal f. The terminal
+// size is not queried on this platform.
+func terminalSize(f *os.File) (int, bool) {
+	return 0, false
+}
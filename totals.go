@@ -0,0 +1,115 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strconv"
+	"strings"
+)
+
+// SetAutoTotals sets the columns which are summed into the totals
+// footer row. The footer row is added to the table at render time and
+// it is labelled "Total" in the first column which is not summed. The
+// numbers are parsed from the cell contents and they can contain
+// comma thousands separators. The cells which are not numbers are
+// ignored. The totals are not rendered in the machine-readable output
+// formats. Calling the function without columns disables the totals.
+func (t *Tabulate) SetAutoTotals(cols ...int) {
+	t.totals = cols
+	t.asData = nil
+}
+
+// totaled creates a new tabulator which has the data rows of this
+// tabulator followed by the totals footer row. The headers and cells
+// are shared with this tabulator.
+func (t *Tabulate) totaled() *Tabulate {
+	view := t.derive()
+	view.totals = nil
+
+	summed := make(map[int]*total)
+	for _, idx := range t.totals {
+		if idx >= 0 {
+			summed[idx] = new(total)
+		}
+	}
+	for _, row := range view.Rows {
+		if row.group {
+			continue
+		}
+		for idx, sum := range summed {
+			if col := row.cell(idx); col != nil && col.span() == 1 {
+				sum.add(col.Content(0))
+			}
+		}
+	}
+
+	footer := &Row{
+		Tab:    view,
+		footer: true,
+	}
+	label := -1
+	for idx := 0; idx < view.numColumns() || label < 0; idx++ {
+		sum, ok := summed[idx]
+		if !ok && label < 0 {
+			label = idx
+			footer.Set(idx, NewText("Total"))
+		} else if ok {
+			footer.Set(idx, NewText(sum.String()))
+		}
+	}
+	view.Rows = append(view.Rows, footer)
+	return view
+}
+
+// total implements a column total. The total keeps track of the
+// number of decimals and the use of thousands separators so that the
+// sum is formatted like the column values.
+type total struct {
+	sum       float64
+	prec      int
+	separator bool
+}
+
+// add adds the value to the total. The values which parseNumber does
+// not accept are ignored.
+func (s *total) add(val string) {
+	v, ok := parseNumber(val)
+	if !ok {
+		return
+	}
+	val = strings.TrimSpace(stripANSI(val))
+	if strings.IndexByte(val, ',') >= 0 {
+		s.separator = true
+	}
+	if idx := strings.IndexByte(val, '.'); idx >= 0 {
+		if prec := len(val) - idx - 1; prec > s.prec {
+			s.prec = prec
+		}
+	}
+	s.sum += v
+}
+
+func (s *total) String() string {
+	str := strconv.FormatFloat(s.sum, 'f', s.prec, 64)
+	if !s.separator {
+		return str
+	}
+	var sign, frac string
+	if strings.HasPrefix(str, "-") {
+		sign = "-"
+		str = str[1:]
+	}
+	if idx := strings.IndexByte(str, '.'); idx >= 0 {
+		frac = str[idx:]
+		str = str[:idx]
+	}
+	n, err := strconv.Atoi(str)
+	if err != nil {
+		return sign + str + frac
+	}
+	return sign + thousands(n) + frac
+}
@@ -0,0 +1,91 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAutoTotals(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Item")
+	tab.Header("Count").SetAlign(MR)
+	tab.Header("Price").SetAlign(MR)
+	tab.AddRow("apples", "1,200", "0.5")
+	tab.AddRow("pears", "34", "1.25")
+	tab.AddRow("plums", "n/a", "2")
+	tab.SetAutoTotals(1, 2)
+	tab.SetRowNumbers(1, "#")
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	match(t, sb.String(), `
+        +---+--------+-------+-------+
+        | # | Item   | Count | Price |
+        +---+--------+-------+-------+
+        | 1 | apples | 1,200 |   0.5 |
+        | 2 | pears  |    34 |  1.25 |
+        | 3 | plums  |   n/a |     2 |
+        +---+--------+-------+-------+
+        |   | Total  | 1,234 |  3.75 |
+        +---+--------+-------+-------+
+`, "TestAutoTotals")
+
+	match(t, tab.Render(CSV), `
+        Item,Count,Price
+        apples,"1,200",0.5
+        pears,34,1.25
+        plums,n/a,2
+`, "TestAutoTotals CSV")
+}
+
+func TestTotal(t *testing.T) {
+	tests := []struct {
+		values   []string
+		expected string
+	}{
+		{[]string{"1", "2", "3"}, "6"},
+		{[]string{"1.5", "2.25"}, "3.75"},
+		{[]string{"999", "1,001"}, "2,000"},
+		{[]string{"-1,000.5", "x", ""}, "-1,000.5"},
+		{[]string{"-1,000.5", "1,000"}, "-0.5"},
+		{[]string{"1", "1,2", "Inf", "NaN", "2"}, "3"},
+	}
+	for _, test := range tests {
+		sum := new(total)
+		for _, v := range test.values {
+			sum.add(v)
+		}
+		if sum.String() != test.expected {
+			t.Errorf("total(%v) = %q, expected %q",
+				test.values, sum.String(), test.expected)
+		}
+	}
+}
+
+func TestAutoTotalsCompact(t *testing.T) {
+	tab := New(ASCII)
+	tab.SetCompact(true)
+	tab.SetHeaders("Item", "Count")
+	tab.AddRow("a", 1)
+	tab.AddStrings("b", "2")
+	tab.AddStrings("c", "3")
+	tab.SetAutoTotals(1)
+
+	match(t, tab.String(), `
+        +-------+-------+
+        | Item  | Count |
+        +-------+-------+
+        | a     | 1     |
+        | b     | 2     |
+        | c     | 3     |
+        +-------+-------+
+        | Total | 6     |
+        +-------+-------+
+`, "TestAutoTotalsCompact")
+}
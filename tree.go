@@ -0,0 +1,113 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"sync"
+)
+
+var (
+	_ = Data((&Tree{}))
+)
+
+// Tree implements the Data interface for hierarchical items. The tree
+// is rendered with indent guides:
+//
+//	root
+//	├── a
+//	│   └── a1
+//	└── b
+type Tree struct {
+	Label    string
+	Children []*Tree
+	lines    []string
+	mu       sync.Mutex
+}
+
+// NewTree creates a new tree with the root label.
+func NewTree(label string) *Tree {
+	return &Tree{
+		Label: label,
+	}
+}
+
+// Add adds a new child item to the tree and returns the child tree.
+func (tree *Tree) Add(label string) *Tree {
+	child := NewTree(label)
+	tree.Children = append(tree.Children, child)
+	tree.mu.Lock()
+	tree.lines = nil
+	tree.mu.Unlock()
+	return child
+}
+
+// layout lays out the tree lines. The Children can be modified
+// directly so Width always lays out the tree and Height and Content
+// use the cached lines.
+func (tree *Tree) layout() []string {
+	var lines []string
+	lines = append(lines, tree.Label)
+	tree.layoutChildren(&lines, "")
+	tree.mu.Lock()
+	tree.lines = lines
+	tree.mu.Unlock()
+	return lines
+}
+
+func (tree *Tree) layoutChildren(lines *[]string, prefix string) {
+	for idx, child := range tree.Children {
+		guide := "├── "
+		indent := "│   "
+		if idx+1 == len(tree.Children) {
+			guide = "└── "
+			indent = "    "
+		}
+		*lines = append(*lines, prefix+guide+child.Label)
+		child.layoutChildren(lines, prefix+indent)
+	}
+}
+
+// Width implements the Data.Width().
+func (tree *Tree) Width(m Measure) int {
+	var max int
+	for _, l := range tree.layout() {
+		w := m(l)
+		if w > max {
+			max = w
+		}
+	}
+	return max
+}
+
+func (tree *Tree) cached() []string {
+	tree.mu.Lock()
+	lines := tree.lines
+	tree.mu.Unlock()
+	if lines == nil {
+		return tree.layout()
+	}
+	return lines
+}
+
+// Height implements the Data.Height().
+func (tree *Tree) Height() int {
+	return len(tree.cached())
+}
+
+// Content implements the Data.Content().
+func (tree *Tree) Content(row int) string {
+	lines := tree.cached()
+	if row < len(lines) {
+		return lines[row]
+	}
+	return ""
+}
+
+func (tree *Tree) String() string {
+	return strings.Join(tree.layout(), "\n")
+}
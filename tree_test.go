@@ -0,0 +1,53 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestTree(t *testing.T) {
+	tree := NewTree("app")
+	lib := tree.Add("lib")
+	lib.Add("util")
+	lib.Add("net")
+	tree.Add("cmd")
+
+	tab := New(ASCII)
+	tab.Header("Module")
+	tab.Header("Dependencies")
+	row := tab.Row()
+	row.Column("app")
+	row.ColumnData(tree)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +--------+--------------+
+        | Module | Dependencies |
+        +--------+--------------+
+        | app    | app          |
+        |        | ├── lib      |
+        |        | │   ├── util |
+        |        | │   └── net  |
+        |        | └── cmd      |
+        +--------+--------------+
+`
+	match(t, sb.String(), expected, "TestTree")
+
+	tab.SetStyle(JSON)
+	data, err := json.Marshal(tab)
+	if err != nil {
+		t.Fatalf("JSON marshal failed: %s", err)
+	}
+	expected = `{"app":{"app":[{"lib":["util","net"]},"cmd"]}}`
+	if string(data) != expected {
+		t.Errorf("TestTree JSON: got %s, expected %s", data, expected)
+	}
+}
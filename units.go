@@ -0,0 +1,92 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+)
+
+// units specify the numeric and suffix part widths of a unit aligned
+// column. The suffix width includes the separator between the number
+// and the unit.
+type units struct {
+	number int
+	suffix int
+}
+
+// splitUnit splits the value into its numeric prefix and unit suffix.
+// The suffix keeps the separator between the number and the unit so
+// that the values are not reformatted.
+func splitUnit(val string) (string, string) {
+	val = strings.TrimSpace(val)
+	idx := strings.IndexFunc(val, func(r rune) bool {
+		return !(r >= '0' && r <= '9') && r != '.' && r != ',' &&
+			r != '-' && r != '+'
+	})
+	if idx < 0 {
+		return val, ""
+	}
+	return val[:idx], val[idx:]
+}
+
+// width returns the width of the unit aligned values.
+func (u *units) width() int {
+	return u.number + u.suffix
+}
+
+// format formats the value so that its numeric part is right aligned
+// and the unit suffix is left aligned.
+func (u *units) format(m Measure, val string) string {
+	if len(val) == 0 {
+		return val
+	}
+	number, suffix := splitUnit(val)
+
+	var sb strings.Builder
+	for i := m(number); i < u.number; i++ {
+		sb.WriteRune(' ')
+	}
+	sb.WriteString(number)
+	sb.WriteString(suffix)
+	for i := m(suffix); i < u.suffix; i++ {
+		sb.WriteRune(' ')
+	}
+	return sb.String()
+}
+
+// measureUnits measures the numeric and suffix widths of the unit
+// aligned columns. The result slice has nil for all columns which are
+// not unit aligned.
+func (t *Tabulate) measureUnits(headers []*Column) []*units {
+	var result []*units
+	for idx, hdr := range headers {
+		if !hdr.UnitAlign {
+			continue
+		}
+		if result == nil {
+			result = make([]*units, len(headers))
+		}
+		u := new(units)
+		t.eachRow(func(row *Row) {
+			col := row.cell(idx)
+			if col == nil || col.span() != 1 {
+				return
+			}
+			for line := 0; line < col.Height(); line++ {
+				number, suffix := splitUnit(col.Content(line))
+				if w := t.Measure(number); w > u.number {
+					u.number = w
+				}
+				if w := t.Measure(suffix); w > u.suffix {
+					u.suffix = w
+				}
+			}
+		})
+		result[idx] = u
+	}
+	return result
+}
@@ -0,0 +1,207 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+var (
+	_ = Data((&Version{}))
+)
+
+// Version implements the Data interface for semantic versions, such
+// as "v1.10.2" or "2.0.0-rc.1".
+type Version struct {
+	str        string
+	valid      bool
+	prefix     string
+	parts      [3]int
+	prerelease string
+	build      string
+	widths     [3]int
+}
+
+// NewVersion creates a new Version data from the version string. If
+// the string is not a valid semantic version, the version is rendered
+// as-is and it sorts by its string value.
+func NewVersion(version string) *Version {
+	v := &Version{
+		str: version,
+	}
+	v.parse()
+	return v
+}
+
+func (v *Version) parse() {
+	s := v.str
+	if strings.HasPrefix(s, "v") {
+		v.prefix = "v"
+		s = s[1:]
+	}
+	if idx := strings.IndexByte(s, '+'); idx >= 0 {
+		v.build = s[idx+1:]
+		s = s[:idx]
+	}
+	if idx := strings.IndexByte(s, '-'); idx >= 0 {
+		v.prerelease = s[idx+1:]
+		s = s[:idx]
+	}
+	parts := strings.Split(s, ".")
+	if len(parts) != 3 {
+		return
+	}
+	for idx, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 {
+			return
+		}
+		v.parts[idx] = n
+	}
+	v.valid = true
+}
+
+// Valid tests if the version is a valid semantic version.
+func (v *Version) Valid() bool {
+	return v.valid
+}
+
+// Width implements the Data.Width().
+func (v *Version) Width(m Measure) int {
+	return m(v.Content(0))
+}
+
+// Height implements the Data.Height().
+func (v *Version) Height() int {
+	return 1
+}
+
+// Content implements the Data.Content().
+func (v *Version) Content(row int) string {
+	if row > 0 {
+		return ""
+	}
+	if !v.valid || v.widths == [3]int{} {
+		return v.str
+	}
+	str := fmt.Sprintf("%s%*d.%*d.%*d", v.prefix,
+		v.widths[0], v.parts[0], v.widths[1], v.parts[1],
+		v.widths[2], v.parts[2])
+	if len(v.prerelease) > 0 {
+		str += "-" + v.prerelease
+	}
+	if len(v.build) > 0 {
+		str += "+" + v.build
+	}
+	return str
+}
+
+func (v *Version) String() string {
+	return v.str
+}
+
+// AlignVersions aligns the components of the Version values in the
+// column idx. The major, minor, and patch numbers are padded to the
+// widths of the widest numbers in the column so that the version
+// components line up.
+func (t *Tabulate) AlignVersions(idx int) {
+	var widths [3]int
+	var versions []*Version
+	for _, row := range t.Rows {
+		col := row.cell(idx)
+		if col == nil {
+			continue
+		}
+		v, ok := col.Data.(*Version)
+		if !ok || !v.valid {
+			continue
+		}
+		versions = append(versions, v)
+		for i, part := range v.parts {
+			if w := len(strconv.Itoa(part)); w > widths[i] {
+				widths[i] = w
+			}
+		}
+	}
+	for _, v := range versions {
+		v.widths = widths
+	}
+}
+
+// LessVersion compares Version values by their semantic version
+// precedence. The invalid versions sort after the valid versions. If
+// either of the values is not Version data, the values are compared
+// with LessData.
+func LessVersion(a, b Data) bool {
+	va, ok := a.(*Version)
+	if !ok {
+		return LessData(a, b)
+	}
+	vb, ok := b.(*Version)
+	if !ok {
+		return LessData(a, b)
+	}
+	if !va.valid || !vb.valid {
+		if va.valid != vb.valid {
+			return va.valid
+		}
+		return va.str < vb.str
+	}
+	for i := 0; i < len(va.parts); i++ {
+		if va.parts[i] != vb.parts[i] {
+			return va.parts[i] < vb.parts[i]
+		}
+	}
+	return comparePrerelease(va.prerelease, vb.prerelease) < 0
+}
+
+// comparePrerelease compares the pre-release versions according to
+// the semantic versioning precedence rules.
+func comparePrerelease(a, b string) int {
+	if a == b {
+		return 0
+	}
+	if len(a) == 0 {
+		return 1
+	}
+	if len(b) == 0 {
+		return -1
+	}
+	as := strings.Split(a, ".")
+	bs := strings.Split(b, ".")
+	for i := 0; i < len(as) && i < len(bs); i++ {
+		an, aErr := strconv.Atoi(as[i])
+		bn, bErr := strconv.Atoi(bs[i])
+		switch {
+		case aErr == nil && bErr == nil:
+			if an != bn {
+				if an < bn {
+					return -1
+				}
+				return 1
+			}
+		case aErr == nil:
+			return -1
+		case bErr == nil:
+			return 1
+		default:
+			if cmp := strings.Compare(as[i], bs[i]); cmp != 0 {
+				return cmp
+			}
+		}
+	}
+	switch {
+	case len(as) < len(bs):
+		return -1
+	case len(as) > len(bs):
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,54 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestVersion(t *testing.T) {
+	tab := New(ASCII)
+	tab.Header("Version").SetLess(LessVersion)
+
+	for _, v := range []string{
+		"v1.10.2", "v1.9.0", "v1.10.0-rc.1", "v1.10.0", "v1.10.0-beta",
+		"v10.0.0",
+	} {
+		tab.Row().ColumnData(NewVersion(v))
+	}
+	tab.Sort(0)
+	tab.AlignVersions(0)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +---------------+
+        | Version       |
+        +---------------+
+        | v 1. 9.0      |
+        | v 1.10.0-beta |
+        | v 1.10.0-rc.1 |
+        | v 1.10.0      |
+        | v 1.10.2      |
+        | v10. 0.0      |
+        +---------------+
+`
+	match(t, sb.String(), expected, "TestVersion")
+}
+
+func TestVersionInvalid(t *testing.T) {
+	if NewVersion("1.2").Valid() {
+		t.Errorf("1.2 is not a valid version")
+	}
+	if !LessVersion(NewVersion("0.0.1"), NewVersion("latest")) {
+		t.Errorf("valid versions must sort before invalid versions")
+	}
+	if LessVersion(NewVersion("1.0.0-alpha.beta"), NewVersion("1.0.0-alpha.1")) {
+		t.Errorf("numeric identifiers must sort before alphanumeric")
+	}
+}
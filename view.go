@@ -0,0 +1,345 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"fmt"
+)
+
+// HideColumn hides the table column idx. The hidden columns are not
+// rendered but they keep their data so they can be shown again with
+// ShowColumn.
+func (t *Tabulate) HideColumn(idx int) {
+	if t.hidden == nil {
+		t.hidden = make(map[int]bool)
+	}
+	t.hidden[idx] = true
+}
+
+// ShowColumn shows the table column idx which was hidden with
+// HideColumn.
+func (t *Tabulate) ShowColumn(idx int) {
+	delete(t.hidden, idx)
+}
+
+// SetColumnOrder sets the order in which the table columns are
+// rendered. The order lists the table column indices in their
+// rendering order and the columns which are not listed are not
+// rendered. The nil order restores the natural column order.
+func (t *Tabulate) SetColumnOrder(order []int) {
+	t.order = order
+}
+
+// SetSplit enables splitting of overly wide tables. If the table is
+// wider than MaxWidth, it is printed as multiple stacked tables which
+// all fit into MaxWidth. The frozen columns are repeated in each of
+// the tables.
+func (t *Tabulate) SetSplit(frozen ...int) {
+	t.split = true
+	t.frozen = frozen
+}
+
+// chunks splits the table columns into chunks which fit into
+// MaxWidth. Each chunk starts with the frozen columns and contains at
+// least one other column. The function returns nil if the table is
+// not split.
+func (t *Tabulate) chunks() [][]int {
+	if !t.split || t.MaxWidth <= 0 {
+		return nil
+	}
+	widths := t.measure(t.Headers)
+	if t.tableWidth(widths) <= t.MaxWidth {
+		return nil
+	}
+	isFrozen := make(map[int]bool)
+	var frozen []int
+	for _, idx := range t.frozen {
+		if idx >= 0 && idx < len(widths) && !isFrozen[idx] {
+			isFrozen[idx] = true
+			frozen = append(frozen, idx)
+		}
+	}
+	chunkWidths := func(columns []int) []int {
+		var result []int
+		for _, idx := range columns {
+			result = append(result, widths[idx])
+		}
+		return result
+	}
+
+	var result [][]int
+	var chunk []int
+	for idx := range widths {
+		if isFrozen[idx] {
+			continue
+		}
+		if len(chunk) > len(frozen) {
+			next := append(append([]int{}, chunk...), idx)
+			if t.tableWidth(chunkWidths(next)) > t.MaxWidth {
+				result = append(result, chunk)
+				chunk = nil
+			}
+		}
+		if chunk == nil {
+			chunk = append(chunk, frozen...)
+		}
+		chunk = append(chunk, idx)
+	}
+	if len(chunk) > len(frozen) {
+		result = append(result, chunk)
+	}
+	return result
+}
+
+// numColumns returns the number of table columns.
+func (t *Tabulate) numColumns() int {
+	count := len(t.Headers)
+	for _, row := range t.Rows {
+		var n int
+		for _, col := range row.Columns {
+			n += col.span()
+		}
+		if n > count {
+			count = n
+		}
+	}
+	return count
+}
+
+// visibleColumns returns the indices of the visible table columns in
+// their rendering order. The function returns nil if all columns are
+// visible in their natural order.
+func (t *Tabulate) visibleColumns() []int {
+	if len(t.hidden) == 0 && t.order == nil {
+		return nil
+	}
+	order := t.order
+	if order == nil {
+		for idx := 0; idx < t.numColumns(); idx++ {
+			order = append(order, idx)
+		}
+	}
+	result := []int{}
+	for _, idx := range order {
+		if idx >= 0 && !t.hidden[idx] {
+			result = append(result, idx)
+		}
+	}
+	return result
+}
+
+// derive creates a new tabulator for a derived rendering view of this
+// tabulator. The view has the configuration, the output, and the
+// column layout attributes of this tabulator. The view's rows are the
+// data rows of this tabulator, followed by the compact rows converted
+// to transient rows, so the view does not have compact rows. The
+// headers and cells are shared with this tabulator.
+func (t *Tabulate) derive() *Tabulate {
+	rows, compactRows := t.snapshot()
+	expanded := make([]*Row, 0, len(rows)+len(compactRows))
+	expanded = append(expanded, rows...)
+	for _, values := range compactRows {
+		expanded = append(expanded, t.compactRow(values))
+	}
+	return t.deriveRows(expanded, nil)
+}
+
+// deriveRows creates a derived rendering view of this tabulator with
+// the argument data rows and compact rows. The view must not append
+// to the argument slices since they can share their backing arrays
+// with this tabulator.
+func (t *Tabulate) deriveRows(rows []*Row, compactRows [][]string) *Tabulate {
+	view := t.Clone()
+	view.Output = t.Output
+	view.renderer = t.renderer
+	view.Vertical = t.Vertical
+	view.hidden = t.hidden
+	view.order = t.order
+	view.split = t.split
+	view.frozen = t.frozen
+	view.Rows = rows
+	view.compactRows = compactRows
+	return view
+}
+
+// printView creates a derived view of the table which has the print
+// settings of the table. The view's style and color mode can be
+// changed for a rendering without modifying this table.
+func (t *Tabulate) printView() *Tabulate {
+	view := t.derive()
+	view.summary = t.summary
+	view.lineFilter = t.lineFilter
+	view.maxRows = t.maxRows
+	view.colorMode = t.colorMode
+	return view
+}
+
+// styled creates a print view of the table with the argument style.
+// The style of the view is not changed by the fallbacks.
+func (t *Tabulate) styled(style Style) *Tabulate {
+	view := t.printView()
+	view.SetStyle(style)
+	view.fixedStyle = true
+	return view
+}
+
+// snapshot returns the data rows and the compact rows of the table.
+// The rows are read while holding the table lock so the snapshot is
+// consistent with the concurrent row and column additions. The data
+// rows are copied so that the columns added to the table rows after
+// the snapshot are not seen by the snapshot.
+func (t *Tabulate) snapshot() ([]*Row, [][]string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	rows := make([]*Row, len(t.Rows))
+	for idx, row := range t.Rows {
+		r := *row
+		r.Columns = row.Columns[:len(row.Columns):len(row.Columns)]
+		rows[idx] = &r
+	}
+	return rows, t.compactRows
+}
+
+// view creates a new tabulator which contains the argument columns of
+// this tabulator. The headers and cells are shared with this
+// tabulator.
+func (t *Tabulate) view(columns []int) *Tabulate {
+	view := t.derive()
+	view.hidden = nil
+	view.order = nil
+	view.split = false
+	view.frozen = nil
+	rows := view.Rows
+	view.Rows = nil
+
+	view.headerGroups = t.viewHeaderGroups(columns)
+	view.Headers = nil
+	if len(t.Headers) > 0 {
+		for _, idx := range columns {
+			if idx < len(t.Headers) {
+				view.Headers = append(view.Headers, t.Headers[idx])
+			} else {
+				view.Headers = append(view.Headers, &Column{
+					Data: NewLinesData(nil),
+				})
+			}
+		}
+	}
+	for _, row := range rows {
+		r := &Row{
+			Tab:    view,
+			group:  row.group,
+			footer: row.footer,
+		}
+		var pending int
+		for i := 0; i < len(columns); i++ {
+			idx := columns[i]
+			col := row.cell(idx)
+			if col == nil {
+				pending++
+				continue
+			}
+			for ; pending > 0; pending-- {
+				r.Columns = append(r.Columns, &Column{
+					Data: NewLinesData(nil),
+				})
+			}
+			if col.span() > 1 {
+				// Span over the consecutive visible columns which
+				// are covered by the original span.
+				c := *col
+				c.Span = 1
+				for i+1 < len(columns) && columns[i+1] > idx &&
+					columns[i+1] < idx+col.span() {
+					c.Span++
+					i++
+				}
+				col = &c
+			}
+			r.Columns = append(r.Columns, col)
+		}
+		view.Rows = append(view.Rows, r)
+	}
+	return view
+}
+
+// records creates a record view of the table. The record view has
+// two columns: the column header and the column value. Each table
+// row is rendered as a block of header:value rows, preceded by a row
+// title.
+func (t *Tabulate) records() *Tabulate {
+	view := t.Clone()
+	view.Headers = nil
+
+	for i, row := range t.Rows {
+		view.Row().SpanColumn(fmt.Sprintf("%d. row", i+1), 2)
+
+		for idx := 0; idx < t.numColumns(); idx++ {
+			col := row.cell(idx)
+			if col == nil {
+				continue
+			}
+			if col.span() > 1 {
+				c := *col
+				c.Span = 1
+				col = &c
+			}
+			r := view.Row()
+			if idx < len(t.Headers) {
+				r.ColumnData(t.Headers[idx].Data)
+			} else {
+				r.Column("")
+			}
+			r.Columns = append(r.Columns, col)
+		}
+	}
+	return view
+}
+
+// viewHeaderGroups returns the header groups of the view of the
+// argument columns. The adjacent view columns of the same header group
+// are joined into one group and the groups without view columns are
+// dropped.
+func (t *Tabulate) viewHeaderGroups(columns []int) []*Column {
+	if len(t.headerGroups) == 0 {
+		return nil
+	}
+	var result []*Column
+	prev := -1
+	for _, idx := range columns {
+		group := t.headerGroupAt(idx)
+		if group >= 0 && group == prev {
+			result[len(result)-1].Span++
+			continue
+		}
+		col := &Column{
+			Data: NewLinesData(nil),
+		}
+		if group >= 0 {
+			c := *t.headerGroups[group]
+			col = &c
+		}
+		col.Span = 1
+		result = append(result, col)
+		prev = group
+	}
+	return result
+}
+
+// headerGroupAt returns the index of the header group of the table
+// column idx. The function returns -1 if the column does not have a
+// header group.
+func (t *Tabulate) headerGroupAt(idx int) int {
+	var start int
+	for i, group := range t.headerGroups {
+		start += group.span()
+		if idx < start {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,137 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHideColumn(t *testing.T) {
+	tab := tabulate(New(ASCII), TL, `Year,Income,Expenses
+2018,100,90
+2019,110,85`)
+	row := tab.Row()
+	row.SpanColumn("Total", 3)
+
+	tab.HideColumn(1)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +------+----------+
+        | Year | Expenses |
+        +------+----------+
+        | 2018 | 90       |
+        | 2019 | 85       |
+        | Total           |
+        +------+----------+
+`
+	match(t, sb.String(), expected, "TestHideColumn")
+
+	tab.ShowColumn(1)
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        +------+--------+----------+
+        | Year | Income | Expenses |
+        +------+--------+----------+
+        | 2018 | 100    | 90       |
+        | 2019 | 110    | 85       |
+        | Total                    |
+        +------+--------+----------+
+`
+	match(t, sb.String(), expected, "TestShowColumn")
+}
+
+func TestSetColumnOrder(t *testing.T) {
+	tab := tabulate(New(ASCII), TL, `Name,Email,Age
+Alice,alice@example.com,30
+Bob,bob@example.com,25`)
+
+	tab.SetColumnOrder([]int{0, 2, 1})
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +-------+-----+-------------------+
+        | Name  | Age | Email             |
+        +-------+-----+-------------------+
+        | Alice | 30  | alice@example.com |
+        | Bob   | 25  | bob@example.com   |
+        +-------+-----+-------------------+
+`
+	match(t, sb.String(), expected, "TestSetColumnOrder")
+
+	tab.SetColumnOrder([]int{2, 0})
+	sb.Reset()
+	tab.Print(&sb)
+	expected = `
+        +-----+-------+
+        | Age | Name  |
+        +-----+-------+
+        | 30  | Alice |
+        | 25  | Bob   |
+        +-----+-------+
+`
+	match(t, sb.String(), expected, "TestSetColumnOrder subset")
+}
+
+func TestVertical(t *testing.T) {
+	tab := tabulate(New(ASCII), TL, `Name,Email,Age
+Alice,alice@example.com,30
+Bob,bob@example.com,25`)
+	tab.Vertical = true
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +-------+-------------------+
+        | 1. row                    |
+        | Name  | Alice             |
+        | Email | alice@example.com |
+        | Age   | 30                |
+        | 2. row                    |
+        | Name  | Bob               |
+        | Email | bob@example.com   |
+        | Age   | 25                |
+        +-------+-------------------+
+`
+	match(t, sb.String(), expected, "TestVertical")
+}
+
+func TestSplit(t *testing.T) {
+	tab := tabulate(New(ASCII), TL, `ID,Name,Email,Age
+1,Alice,alice@example.com,30
+2,Bob,bob@example.com,25`)
+	tab.MaxWidth = 30
+	tab.SetSplit(0)
+
+	var sb strings.Builder
+	tab.Print(&sb)
+	expected := `
+        +----+-------+
+        | ID | Name  |
+        +----+-------+
+        | 1  | Alice |
+        | 2  | Bob   |
+        +----+-------+
+        +----+-------------------+
+        | ID | Email             |
+        +----+-------------------+
+        | 1  | alice@example.com |
+        | 2  | bob@example.com   |
+        +----+-------------------+
+        +----+-----+
+        | ID | Age |
+        +----+-----+
+        | 1  | 30  |
+        | 2  | 25  |
+        +----+-----+
+`
+	match(t, sb.String(), expected, "TestSplit")
+}
@@ -0,0 +1,65 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// Watch calls the function fn every interval and prints the returned
+// table into the writer. Each new rendering replaces the previous one
+// in place by moving the cursor up with ANSI escape sequences, like
+// watch(1) does. The function returns nil when the context is done or
+// the first error returned by fn or encountered when printing the
+// table.
+func Watch(ctx context.Context, w io.Writer, interval time.Duration,
+	fn func() (*Tabulate, error)) error {
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	var height int
+	for {
+		tab, err := fn()
+		if err != nil {
+			return err
+		}
+		height, err = redraw(w, tab, height)
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// redraw prints the table into the writer over the previous rendering
+// which was height lines tall. The function returns the height of the
+// new rendering.
+func redraw(w io.Writer, tab *Tabulate, height int) (int, error) {
+	var sb strings.Builder
+	if height > 0 {
+		fmt.Fprintf(&sb, "\x1b[%dA", height)
+	}
+	sb.WriteString("\r\x1b[J")
+	start := sb.Len()
+	if _, err := tab.Fprint(&sb); err != nil {
+		return height, err
+	}
+	height = strings.Count(sb.String()[start:], "\n")
+	if _, err := io.WriteString(w, sb.String()); err != nil {
+		return height, err
+	}
+	return height, nil
+}
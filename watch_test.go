@@ -0,0 +1,50 @@
+//
+// Copyright (c) 2026 Markku Rossi
+//
+// All rights reserved.
+//
+
+package tabulate
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var count int
+	var sb strings.Builder
+	err := Watch(ctx, &sb, time.Millisecond, func() (*Tabulate, error) {
+		count++
+		if count == 2 {
+			cancel()
+		}
+		tab := New(Plain)
+		tab.Header("Count")
+		tab.AddRow(count)
+		return tab, nil
+	})
+	if err != nil {
+		t.Fatalf("Watch failed: %v", err)
+	}
+	expected := "\r\x1b[J Count \n 1     \n" +
+		"\x1b[2A\r\x1b[J Count \n 2     \n"
+	if sb.String() != expected {
+		t.Errorf("TestWatch: got %q, expected %q", sb.String(), expected)
+	}
+
+	failure := errors.New("failure")
+	err = Watch(context.Background(), &sb, time.Millisecond,
+		func() (*Tabulate, error) {
+			return nil, failure
+		})
+	if err != failure {
+		t.Errorf("Watch returned %v, expected %v", err, failure)
+	}
+}